@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/export"
+	"github.com/port-labs/terraform-provider-port-labs/v2/version"
+)
+
+func main() {
+	var baseUrl, clientID, secret, out string
+
+	flag.StringVar(&baseUrl, "base-url", os.Getenv("PORT_BASE_URL"), "the Port API URL, defaults to the environment variable PORT_BASE_URL")
+	flag.StringVar(&clientID, "client-id", os.Getenv("PORT_CLIENT_ID"), "the Port client ID, defaults to the environment variable PORT_CLIENT_ID")
+	flag.StringVar(&secret, "secret", os.Getenv("PORT_CLIENT_SECRET"), "the Port client secret, defaults to the environment variable PORT_CLIENT_SECRET")
+	flag.StringVar(&out, "out", "", "the file to write the configuration to, defaults to the standard output")
+	flag.Parse()
+
+	if baseUrl == "" {
+		baseUrl = consts.DefaultBaseUrl
+	}
+	if clientID == "" || secret == "" {
+		log.Fatal("client ID and secret are required, either set with -client-id and -secret or the environment variables PORT_CLIENT_ID and PORT_CLIENT_SECRET")
+	}
+
+	if err := run(context.Background(), baseUrl, clientID, secret, out); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, baseUrl, clientID, secret, out string) error {
+	c, err := cli.New(baseUrl, cli.WithHeader("User-Agent", version.ProviderVersion))
+	if err != nil {
+		return err
+	}
+	if _, err = c.Authenticate(ctx, clientID, secret); err != nil {
+		return fmt.Errorf("failed to authenticate with Port-labs: %w", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	return export.Export(ctx, c, w)
+}
@@ -0,0 +1,11 @@
+# import an existing entity, the import ID of an entity is <blueprint>:<identifier>
+import {
+  to = port_entity.checkout
+  id = provider::port::entity_ref("microservice", "checkout")
+}
+
+resource "port_entity" "checkout" {
+  identifier = "checkout"
+  title      = "Checkout"
+  blueprint  = "microservice"
+}
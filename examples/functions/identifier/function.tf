@@ -0,0 +1,12 @@
+locals {
+  services = ["Checkout Service", "Payments API", "Café Menu"]
+}
+
+resource "port_entity" "service" {
+  for_each = toset(local.services)
+
+  # "checkout-service", "payments-api" and "cafe-menu"
+  identifier = provider::port::identifier(each.value)
+  title      = each.value
+  blueprint  = "microservice"
+}
@@ -0,0 +1,16 @@
+resource "port_webhook" "kubernetes" {
+  identifier = "kubernetes"
+  title      = "Kubernetes"
+  enabled    = true
+  mappings = [
+    {
+      blueprint = "microservice"
+      filter    = ".headers.\"x-event\" == \"deployment\""
+      entity = {
+        # .body.metadata.labels["app.kubernetes.io/name"]
+        identifier = provider::port::jq_path(["body", "metadata", "labels", "app.kubernetes.io/name"])
+        title      = provider::port::jq_path(["body", "metadata", "name"])
+      }
+    }
+  ]
+}
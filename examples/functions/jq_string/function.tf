@@ -0,0 +1,23 @@
+resource "port_integration" "my_custom_integration" {
+  installation_id       = "my-custom-integration-id"
+  title                 = "My Custom Integration"
+  installation_app_type = "WEBHOOK"
+  config = jsonencode({
+    resources = [{
+      kind = "my-custom-kind"
+      selector = {
+        query = "true"
+      }
+      port = {
+        entity = {
+          mappings = [{
+            identifier = ".id"
+            title      = ".title"
+            # the mappings are jq expressions, jq_string("microservice") is "\"microservice\""
+            blueprint = provider::port::jq_string(port_blueprint.microservice.identifier)
+          }]
+        }
+      }
+    }]
+  })
+}
@@ -0,0 +1,2 @@
+# Actions can be imported using their identifier
+terraform import port_action.restart_microservice examples-action-restart-microservice
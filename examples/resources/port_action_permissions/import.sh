@@ -0,0 +1,2 @@
+# Action permissions can be imported using the identifier of their action
+terraform import port_action_permissions.restart_microservice_permissions examples-action-permissions-restart-mcsrvc
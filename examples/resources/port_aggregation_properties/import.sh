@@ -0,0 +1,2 @@
+# Aggregation properties can be imported using the identifier of their blueprint
+terraform import port_aggregation_properties.parent_aggregation_properties parent
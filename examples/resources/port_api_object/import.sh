@@ -0,0 +1,3 @@
+# API objects can be imported using the path of their collection and their identifier separated by a colon,
+# the body of an imported object has all the fields of the object in Port
+terraform import port_api_object.github_webhook v1/webhooks:github
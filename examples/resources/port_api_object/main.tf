@@ -0,0 +1,15 @@
+resource "port_api_object" "github_webhook" {
+  path       = "v1/webhooks"
+  identifier = "github"
+  body = jsonencode({
+    identifier = "github"
+    title      = "GitHub"
+    enabled    = true
+    mappings   = []
+  })
+  computed_fields = ["url", "webhookKey"]
+}
+
+output "github_webhook_url" {
+  value = jsondecode(port_api_object.github_webhook.output).url
+}
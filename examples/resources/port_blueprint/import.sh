@@ -0,0 +1,2 @@
+# Blueprints can be imported using their identifier
+terraform import port_blueprint.microservice hedwig-microservice
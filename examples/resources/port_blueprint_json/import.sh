@@ -0,0 +1,3 @@
+# Blueprints can be imported using their identifier,
+# the definition of an imported blueprint has all the fields of the blueprint in Port
+terraform import port_blueprint_json.microservice microservice
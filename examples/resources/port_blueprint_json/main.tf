@@ -0,0 +1,3 @@
+resource "port_blueprint_json" "microservice" {
+  definition = file("${path.module}/microservice.json")
+}
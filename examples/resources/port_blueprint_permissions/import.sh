@@ -0,0 +1,2 @@
+# Blueprint permissions can be imported using the identifier of their blueprint
+terraform import port_blueprint_permissions.microservice_permissions examples-blueprint-perms-srvc
@@ -0,0 +1,2 @@
+# Blueprint properties can be imported using the blueprint identifier and the property identifier separated by a colon
+terraform import port_blueprint_property.tier service:tier
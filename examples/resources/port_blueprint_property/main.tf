@@ -0,0 +1,33 @@
+resource "port_blueprint" "service" {
+  title                      = "Service"
+  icon                       = "Microservice"
+  identifier                 = "service"
+  description                = ""
+  ignore_external_properties = true
+  properties = {
+    string_props = {
+      "language" = {
+        title = "Language"
+      }
+    }
+  }
+}
+
+resource "port_blueprint_property" "tier" {
+  blueprint_identifier = port_blueprint.service.identifier
+  property_identifier  = "tier"
+  string_prop = {
+    title    = "Tier"
+    required = true
+    enum     = ["gold", "silver", "bronze"]
+  }
+}
+
+resource "port_blueprint_property" "replicas" {
+  blueprint_identifier = port_blueprint.service.identifier
+  property_identifier  = "replicas"
+  number_prop = {
+    title   = "Replicas"
+    minimum = 1
+  }
+}
@@ -0,0 +1,2 @@
+# Blueprint relations can be imported using the blueprint identifier and the relation identifier separated by a colon
+terraform import port_blueprint_relation.service_environment service:environment
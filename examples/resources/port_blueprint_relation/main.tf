@@ -0,0 +1,31 @@
+resource "port_blueprint" "service" {
+  title                     = "Service"
+  icon                      = "Microservice"
+  identifier                = "service"
+  description               = ""
+  ignore_external_relations = true
+}
+
+resource "port_blueprint" "environment" {
+  title                     = "Environment"
+  icon                      = "Environment"
+  identifier                = "environment"
+  description               = ""
+  ignore_external_relations = true
+}
+
+resource "port_blueprint_relation" "service_environment" {
+  blueprint_identifier = port_blueprint.service.identifier
+  relation_identifier  = "environment"
+  target               = port_blueprint.environment.identifier
+  title                = "Environment"
+  required             = true
+}
+
+resource "port_blueprint_relation" "environment_services" {
+  blueprint_identifier = port_blueprint.environment.identifier
+  relation_identifier  = "services"
+  target               = port_blueprint.service.identifier
+  title                = "Services"
+  many                 = true
+}
@@ -0,0 +1,2 @@
+# Entities can be imported using the blueprint identifier and the entity identifier separated by a colon
+terraform import port_entity.microservice examples-entity-srvc:my-service
@@ -0,0 +1,5 @@
+# Folders can be imported using the sidebar identifier and the folder identifier separated by a colon
+terraform import port_folder.engineering catalog:engineering
+
+# Folders of the catalog sidebar can also be imported using their identifier alone
+terraform import port_folder.engineering engineering
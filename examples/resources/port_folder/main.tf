@@ -0,0 +1,17 @@
+resource "port_folder" "engineering" {
+  identifier = "engineering"
+  title      = "Engineering"
+}
+
+resource "port_folder" "services" {
+  identifier = "services"
+  title      = "Services"
+  parent     = port_folder.engineering.identifier
+}
+
+resource "port_folder" "infrastructure" {
+  identifier = "infrastructure"
+  title      = "Infrastructure"
+  parent     = port_folder.engineering.identifier
+  after      = port_folder.services.identifier
+}
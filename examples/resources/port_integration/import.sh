@@ -0,0 +1,2 @@
+# Integrations can be imported using their installation ID
+terraform import port_integration.my_custom_integration my-custom-integration-id
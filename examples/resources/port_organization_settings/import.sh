@@ -0,0 +1,2 @@
+# The organization settings can be imported using any identifier, as there is only one organization
+terraform import port_organization_settings.settings organization
@@ -0,0 +1,10 @@
+resource "port_organization_settings" "settings" {
+  portal_title = "Acme Developer Portal"
+  portal_icon  = "https://example.com/logo.png"
+  announcement = {
+    enabled = true
+    content = "Scheduled maintenance on Sunday"
+    link    = "https://status.example.com"
+  }
+  hidden_blueprints = ["_user", "_team"]
+}
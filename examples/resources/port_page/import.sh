@@ -0,0 +1,2 @@
+# Pages can be imported using their identifier
+terraform import port_page.microservice_dashboard_page microservice_dashboard_page
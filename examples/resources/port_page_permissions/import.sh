@@ -0,0 +1,2 @@
+# Page permissions can be imported using the identifier of their page
+terraform import port_page_permissions.microservice_dashboard_page_permissions microservice_dashboard_page
@@ -0,0 +1,2 @@
+# Scorecards can be imported using the blueprint identifier and the scorecard identifier separated by a colon
+terraform import port_scorecard.production_readiness examples-scorecard-svc:production-readiness
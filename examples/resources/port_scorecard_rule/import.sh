@@ -0,0 +1,2 @@
+# Scorecard rules can be imported using the blueprint identifier, the scorecard identifier and the rule identifier separated by colons
+terraform import port_scorecard_rule.has_author microservice:productionReadiness:hasAuthor
@@ -0,0 +1,52 @@
+resource "port_blueprint" "microservice" {
+  title      = "microservice"
+  icon       = "Terraform"
+  identifier = "microservice"
+  properties = {
+    string_props = {
+      "author" = {
+        title = "Author"
+      }
+    }
+  }
+}
+
+resource "port_scorecard" "production_readiness" {
+  identifier            = "productionReadiness"
+  title                 = "Production Readiness"
+  blueprint             = port_blueprint.microservice.identifier
+  ignore_external_rules = true
+  rules = [
+    {
+      identifier = "hasTeam"
+      title      = "Has Team"
+      level      = "Bronze"
+      query = {
+        combinator = "and"
+        conditions = [
+          jsonencode({
+            property = "$team"
+            operator = "isNotEmpty"
+          })
+        ]
+      }
+    }
+  ]
+}
+
+resource "port_scorecard_rule" "has_author" {
+  blueprint_identifier = port_scorecard.production_readiness.blueprint
+  scorecard_identifier = port_scorecard.production_readiness.identifier
+  rule_identifier      = "hasAuthor"
+  title                = "Has Author"
+  level                = "Gold"
+  query = {
+    combinator = "and"
+    conditions = [
+      jsonencode({
+        property = "author"
+        operator = "isNotEmpty"
+      })
+    ]
+  }
+}
@@ -0,0 +1,2 @@
+# Teams can be imported using their name
+terraform import port_team.example example
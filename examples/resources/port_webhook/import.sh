@@ -0,0 +1,2 @@
+# Webhooks can be imported using their identifier
+terraform import port_webhook.github github
@@ -2,12 +2,17 @@ package acctest
 
 import (
 	"fmt"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/porttest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 	"github.com/port-labs/terraform-provider-port-labs/v2/provider"
 )
 
@@ -17,18 +22,105 @@ var (
 	// CLI command executed to create a provider server to which the CLI can
 	// reattach.
 	TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-		consts.ProviderName: providerserver.NewProtocol6WithError(provider.New()),
+		consts.ProviderName: providerserver.NewProtocol6WithError(provider.NewWithClientOptions(cli.WithTransport(Transport))),
 	}
 )
 
-var ProviderConfig = fmt.Sprintf(`provider "port" {
+// Transport sends the requests of the acceptance tests to the Port API, when PORT_ACC_MODE is record it records them
+// into a cassette in the testdata directory of the package under test, and when it is replay it replays them from
+// the cassette instead of sending them, skipping the tests that weren't recorded. It is nil otherwise, which keeps the default transport of the client
+var Transport = newTransport()
+
+func newTransport() http.RoundTripper {
+	mode := os.Getenv("PORT_ACC_MODE")
+	if mode == "" {
+		return nil
+	}
+	r, err := newRecorder(mode, cassettePath)
+	if err != nil {
+		panic(err)
+	}
+	utils.IDGenerator = r.GenID
+	return r
+}
+
+// FakeAPI is the in-memory Port API the acceptance tests run against when PORT_ACC_FAKE_API is true, it is nil when
+// the tests run against Port
+var FakeAPI = newFakeAPI()
+
+// BaseUrl, ClientID and ClientSecret are the Port API and the credentials the acceptance tests run against
+var BaseUrl, ClientID, ClientSecret = apiCredentials()
+
+// ciUserName is the user the team tests add to their team when they run against the fake API or replay a cassette
+const ciUserName = "ci-user@porttest.io"
+
+var ProviderConfig = providerConfig(true, false)
+
+// ProviderConfigBetaFeaturesDisabled is used to test that the resources in beta can't be used without enabling them
+var ProviderConfigBetaFeaturesDisabled = providerConfig(false, false)
+
+// ProviderConfigValidateSecretReferences is used to test the plan check of the secrets that actions reference
+var ProviderConfigValidateSecretReferences = providerConfig(true, true)
+
+func newFakeAPI() *porttest.Server {
+	if os.Getenv("PORT_ACC_FAKE_API") != "true" {
+		return nil
+	}
+	return porttest.NewServer()
+}
+
+func apiCredentials() (string, string, string) {
+	if FakeAPI != nil {
+		return setCredentialsEnv(FakeAPI.URL, FakeAPI.ClientID, FakeAPI.ClientSecret)
+	}
+	// the credentials are redacted from the cassettes, and the requests are replayed whatever the url is
+	if os.Getenv("PORT_ACC_MODE") == modeReplay {
+		return setCredentialsEnv(consts.DefaultBaseUrl, redacted, redacted)
+	}
+	return os.Getenv("PORT_BASE_URL"), os.Getenv("PORT_CLIENT_ID"), os.Getenv("PORT_CLIENT_SECRET")
+}
+
+// setCredentialsEnv sets the environment variables of the provider to the given credentials, for the tests whose
+// configuration doesn't have a provider block, and the user the team tests add when it isn't set
+func setCredentialsEnv(baseUrl string, clientID string, clientSecret string) (string, string, string) {
+	env := map[string]string{"PORT_BASE_URL": baseUrl, "PORT_CLIENT_ID": clientID, "PORT_CLIENT_SECRET": clientSecret}
+	if os.Getenv("CI_USER_NAME") == "" {
+		env["CI_USER_NAME"] = ciUserName
+	}
+	for key, value := range env {
+		if err := os.Setenv(key, value); err != nil {
+			panic(err)
+		}
+	}
+	return baseUrl, clientID, clientSecret
+}
+
+func providerConfig(betaFeaturesEnabled bool, validateSecretReferences bool) string {
+	return fmt.Sprintf(`provider "port" {
 	client_id = "%s"
 	secret = "%s"
 	base_url = "%s"
+	beta_features_enabled = %t
+	validate_secret_references = %t
 	}
-`, os.Getenv("PORT_CLIENT_ID"), os.Getenv("PORT_CLIENT_SECRET"), os.Getenv("PORT_BASE_URL"))
+`, ClientID, ClientSecret, BaseUrl, betaFeaturesEnabled, validateSecretReferences)
+}
 
 func TestAccPreCheck(t *testing.T) {
+	if r, ok := Transport.(*recorder); ok {
+		recorded, err := r.startTest(strings.Split(t.Name(), "/")[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !recorded {
+			t.Skipf("%s isn't recorded in %s, record it with PORT_ACC_MODE=%s", t.Name(), cassettePath, modeRecord)
+		}
+	}
+
+	if FakeAPI != nil || os.Getenv("PORT_ACC_MODE") == modeReplay {
+		return
+	}
+
 	if v := os.Getenv("PORT_CLIENT_ID"); v == "" {
 		t.Fatal("PORT_CLIENT_ID must be set for acceptance tests")
 	}
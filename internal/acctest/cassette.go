@@ -0,0 +1,310 @@
+package acctest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+const (
+	modeRecord = "record"
+	modeReplay = "replay"
+
+	// cassettePath is relative to the package under test, which is the working directory of go test
+	cassettePath = "testdata/port-api-cassette.json"
+
+	redacted = "REDACTED"
+)
+
+// redactedFields are the JSON fields of the authentication request and response whose values are never written to a
+// cassette, the same fields elsewhere, like the client id of a property spec, are test data that is replayed
+var redactedFields = []string{"clientId", "clientSecret", "accessToken"}
+
+const authenticationPath = "/v1/auth/access_token"
+
+// cassette is the recordings of the requests the acceptance tests of a package sent to the Port API, by test
+type cassette struct {
+	Tests map[string]*recording `json:"tests"`
+}
+
+// recording is the requests a test sent to the Port API
+type recording struct {
+	// Seed is mixed into the identifiers generated by utils.GenID, so that a new recording doesn't collide with
+	// leftovers of earlier recordings, and a replay generates the identifiers of its recording
+	Seed         string        `json:"seed"`
+	Interactions []interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Method       string `json:"method"`
+	URL          string `json:"url"`
+	RequestBody  any    `json:"requestBody,omitempty"`
+	StatusCode   int    `json:"statusCode"`
+	ResponseBody any    `json:"responseBody,omitempty"`
+}
+
+// recorder is an http.RoundTripper that records the requests to the Port API into a cassette, or replays them from one
+type recorder struct {
+	mode      string
+	path      string
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette cassette
+	// current is the acceptance test that runs, the requests of the provider are sent outside the goroutine of the
+	// test so they can't be attributed to it by the call stack
+	current string
+	// recorded are the tests recorded by this run, the recordings of the other tests in the cassette are kept
+	recorded map[string]bool
+	// replayed counts the replays of every request of every test, a request that was sent several times is replayed
+	// in the order it was recorded
+	replayed map[string]int
+	// generated counts the identifiers generated by every test
+	generated map[string]int
+}
+
+func newRecorder(mode string, path string) (*recorder, error) {
+	if mode != modeRecord && mode != modeReplay {
+		return nil, fmt.Errorf("PORT_ACC_MODE must be %s or %s, got %q", modeRecord, modeReplay, mode)
+	}
+	r := &recorder{
+		mode:      mode,
+		path:      path,
+		transport: http.DefaultTransport,
+		cassette:  cassette{Tests: make(map[string]*recording)},
+		recorded:  make(map[string]bool),
+		replayed:  make(map[string]int),
+		generated: make(map[string]int),
+	}
+	// a recording of some of the tests is merged into the existing cassette, and a package without a cassette has no
+	// recorded tests to replay
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the cassette %s: %w", path, err)
+	}
+	if err = json.Unmarshal(content, &r.cassette); err != nil {
+		return nil, fmt.Errorf("failed to parse the cassette %s: %w", path, err)
+	}
+	if r.cassette.Tests == nil {
+		r.cassette.Tests = make(map[string]*recording)
+	}
+	return r, nil
+}
+
+// startTest is called when an acceptance test starts, it returns false if the test is replayed and wasn't recorded.
+// A recorded test is added to the cassette even if it sends no requests, like the tests of plan time errors
+func (r *recorder) startTest(test string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.current = test
+	if r.mode == modeRecord {
+		r.recordingOf(test)
+		return true, r.save()
+	}
+	return r.cassette.Tests[test] != nil, nil
+}
+
+// recordingOf returns the recording of a test, the first time a test is recorded by this run it replaces the
+// recording of the test in the cassette
+func (r *recorder) recordingOf(test string) *recording {
+	if r.mode == modeRecord && !r.recorded[test] {
+		seed := make([]byte, 8)
+		if _, err := rand.Read(seed); err != nil {
+			panic(err)
+		}
+		r.cassette.Tests[test] = &recording{Seed: hex.EncodeToString(seed)}
+		r.recorded[test] = true
+	}
+	if rec, ok := r.cassette.Tests[test]; ok {
+		return rec
+	}
+	return &recording{}
+}
+
+// testOf returns the test that sends a request or generates an identifier, from the call stack or the acceptance test
+// that runs
+func (r *recorder) testOf(fromStack string) string {
+	if fromStack != "" {
+		return fromStack
+	}
+	return r.current
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil {
+		var err error
+		if requestBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+	i := interaction{Method: req.Method, URL: req.URL.RequestURI(), RequestBody: redactBody(req.URL.Path, requestBody)}
+	fromStack := currentTestName()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	test := r.testOf(fromStack)
+	if r.mode == modeReplay {
+		return r.replay(req, test, i)
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	i.StatusCode = resp.StatusCode
+	i.ResponseBody = redactBody(req.URL.Path, responseBody)
+	rec := r.recordingOf(test)
+	rec.Interactions = append(rec.Interactions, i)
+	if err = r.save(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (r *recorder) replay(req *http.Request, test string, i interaction) (*http.Response, error) {
+	key := i.key()
+	var matches []interaction
+	for _, recorded := range r.recordingOf(test).Interactions {
+		if recorded.key() == key {
+			matches = append(matches, recorded)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("the request %s %s of %s wasn't recorded, record the test again with PORT_ACC_MODE=%s", i.Method, i.URL, test, modeRecord)
+	}
+	n := r.replayed[test+" "+key]
+	r.replayed[test+" "+key]++
+	if n >= len(matches) {
+		n = len(matches) - 1
+	}
+
+	var body []byte
+	switch b := matches[n].ResponseBody.(type) {
+	case nil:
+	case string:
+		body = []byte(b)
+	default:
+		var err error
+		if body, err = json.Marshal(b); err != nil {
+			return nil, err
+		}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", matches[n].StatusCode, http.StatusText(matches[n].StatusCode)),
+		StatusCode:    matches[n].StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *recorder) save() error {
+	content, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(content, '\n'), 0644)
+}
+
+// GenID generates identifiers that only depend on the seed of the recording of the test, the test that generates them and how
+// many identifiers the test generated before, so that a replay generates the identifiers of its recording even when
+// only some of the recorded tests are replayed
+func (r *recorder) GenID() string {
+	fromStack := currentTestName()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	test := r.testOf(fromStack)
+	r.generated[test]++
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%d", r.recordingOf(test).Seed, test, r.generated[test])))
+	return fmt.Sprintf("t-%x", sum[:9])
+}
+
+// key identifies the requests that are replayed with the responses of an interaction
+func (i interaction) key() string {
+	body, _ := json.Marshal(i.RequestBody)
+	return fmt.Sprintf("%s %s %s", i.Method, i.URL, body)
+}
+
+// redactBody decodes a JSON body and replaces the values of the redacted fields of the authentication, bodies that
+// aren't JSON are kept as strings
+func redactBody(urlPath string, body []byte) any {
+	if len(body) == 0 {
+		return nil
+	}
+	var decoded any
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return string(body)
+	}
+	if !strings.HasSuffix(urlPath, authenticationPath) {
+		return decoded
+	}
+	return redact(decoded)
+}
+
+func redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			v[key] = redact(value)
+			for _, field := range redactedFields {
+				if key == field {
+					v[key] = redacted
+				}
+			}
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = redact(value)
+		}
+	}
+	return v
+}
+
+// currentTestName returns the name of the test function in the call stack, or an empty string if there's none
+func currentTestName() string {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		// test functions are named like github.com/port-labs/.../port/blueprint_test.TestAccPortBlueprint.func1, the
+		// functions of the testing framework like resource.Test and resource.TestCheckResourceAttr aren't tests
+		name := frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+		if parts := strings.Split(name, "."); len(parts) > 1 && strings.HasPrefix(parts[1], "Test") && !strings.HasPrefix(frame.Function, "github.com/hashicorp/") {
+			return parts[1]
+		}
+		if !more {
+			return ""
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/porttest"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "cassette.json")
+	server := porttest.NewServer()
+	defer server.Close()
+
+	recording, err := newRecorder(modeRecord, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recordedIdentifier := recording.GenID()
+	if recordedIdentifier == recording.GenID() {
+		t.Error("expected the identifiers generated by a test to be unique")
+	}
+	createBlueprint(t, server.URL, server.ClientID, server.ClientSecret, recording, recordedIdentifier)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(content), server.ClientSecret) || strings.Contains(string(content), "porttest-access-token") {
+		t.Errorf("expected the credentials to be redacted from the cassette, got:\n%s", content)
+	}
+
+	// the server is closed so that the replay fails if it sends a request
+	server.Close()
+	replaying, err := newRecorder(modeReplay, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identifier := replaying.GenID(); identifier != recordedIdentifier {
+		t.Errorf("expected the replay to generate the recorded identifier %s, got %s", recordedIdentifier, identifier)
+	}
+	createBlueprint(t, server.URL, redacted, redacted, replaying, recordedIdentifier)
+}
+
+func createBlueprint(t *testing.T, baseURL string, clientID string, clientSecret string, r *recorder, identifier string) {
+	ctx := context.Background()
+	portClient, err := cli.New(baseURL, cli.WithTransport(r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = portClient.Authenticate(ctx, clientID, clientSecret); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = portClient.CreateBlueprint(ctx, &cli.Blueprint{Identifier: identifier, Title: "Service"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	b, _, err := portClient.ReadBlueprint(ctx, identifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Title != "Service" {
+		t.Errorf("expected the blueprint title to be Service, got %s", b.Title)
+	}
+}
+
+// readBlueprintOf sends the requests of an acceptance test, outside the goroutine of the test like the provider does
+func readBlueprintOf(r *recorder, test string, baseURL string, clientID string, clientSecret string) (*cli.Blueprint, error) {
+	recorded, err := r.startTest(test)
+	if err != nil {
+		return nil, err
+	}
+	if !recorded {
+		return nil, fmt.Errorf("%s wasn't recorded", test)
+	}
+	type result struct {
+		b   *cli.Blueprint
+		err error
+	}
+	done := make(chan result)
+	go func() {
+		ctx := context.Background()
+		portClient, err := cli.New(baseURL, cli.WithTransport(r))
+		if err == nil {
+			_, err = portClient.Authenticate(ctx, clientID, clientSecret)
+		}
+		if err != nil {
+			done <- result{err: err}
+			return
+		}
+		b, _, err := portClient.ReadBlueprint(ctx, "service")
+		done <- result{b, err}
+	}()
+	res := <-done
+	return res.b, res.err
+}
+
+func TestRecordMergesTests(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "cassette.json")
+	server := porttest.NewServer()
+	defer server.Close()
+	portClient, err := cli.New(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = portClient.Authenticate(context.Background(), server.ClientID, server.ClientSecret); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := newRecorder(modeRecord, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = portClient.CreateBlueprint(context.Background(), &cli.Blueprint{Identifier: "service", Title: "Service"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []string{"TestAccFirst", "TestAccSecond"} {
+		if _, err = readBlueprintOf(first, test, server.URL, server.ClientID, server.ClientSecret); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// only the first test is recorded again, after the blueprint changed
+	if _, err = portClient.UpdateBlueprint(context.Background(), &cli.Blueprint{Identifier: "service", Title: "Updated"}, "service"); err != nil {
+		t.Fatal(err)
+	}
+	second, err := newRecorder(modeRecord, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = readBlueprintOf(second, "TestAccFirst", server.URL, server.ClientID, server.ClientSecret); err != nil {
+		t.Fatal(err)
+	}
+	if second.cassette.Tests["TestAccFirst"].Seed == first.cassette.Tests["TestAccFirst"].Seed {
+		t.Error("expected the recorded test to get a new seed")
+	}
+	if second.cassette.Tests["TestAccSecond"].Seed != first.cassette.Tests["TestAccSecond"].Seed {
+		t.Error("expected the seed of the test that wasn't recorded to be kept")
+	}
+
+	server.Close()
+	replaying, err := newRecorder(modeReplay, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for test, title := range map[string]string{"TestAccFirst": "Updated", "TestAccSecond": "Service"} {
+		b, err := readBlueprintOf(replaying, test, server.URL, redacted, redacted)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b.Title != title {
+			t.Errorf("expected %s to replay the blueprint title %s, got %s", test, title, b.Title)
+		}
+	}
+	if _, err = readBlueprintOf(replaying, "TestAccThird", server.URL, redacted, redacted); err == nil {
+		t.Error("expected a test that wasn't recorded not to be replayed")
+	}
+}
+
+func TestRecordTestWithoutRequests(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "cassette.json")
+	recording, err := newRecorder(modeRecord, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = recording.startTest("TestAccPlanError"); err != nil {
+		t.Fatal(err)
+	}
+
+	replaying, err := newRecorder(modeReplay, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recorded, err := replaying.startTest("TestAccPlanError"); err != nil || !recorded {
+		t.Errorf("expected a recorded test without requests to be replayed, got %t, %v", recorded, err)
+	}
+}
@@ -0,0 +1,99 @@
+package acctest
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+)
+
+// CheckStateUpgrade upgrades the fixture state stored in priorStatePath with the upgrader of version, and checks
+// that it matches the fixture state in expectedStatePath, which must be in the layout of the current schema
+func CheckStateUpgrade(t *testing.T, r resource.ResourceWithUpgradeState, version int64, priorStatePath string, expectedStatePath string) {
+	t.Helper()
+	ctx := context.Background()
+
+	s := resourceSchema(t, r)
+	if s.Version <= version {
+		t.Fatalf("expected the schema version to be greater than %d, got %d", version, s.Version)
+	}
+	upgrader, ok := r.UpgradeState(ctx)[version]
+	if !ok {
+		t.Fatalf("the resource has no state upgrader of version %d", version)
+	}
+
+	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: s}}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: readFixture(t, priorStatePath)}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to upgrade the state %s: %v", priorStatePath, resp.Diagnostics)
+	}
+	checkState(t, s, resp.State.Raw, expectedStatePath)
+}
+
+// CheckStateMove moves the fixture state of a sourceTypeName resource of this provider, stored in sourceStatePath,
+// with the state movers of the resource, and checks that it matches the fixture state in expectedStatePath
+func CheckStateMove(t *testing.T, r resource.ResourceWithMoveState, sourceTypeName string, sourceSchemaVersion int64, sourceStatePath string, expectedStatePath string) {
+	t.Helper()
+	ctx := context.Background()
+
+	s := resourceSchema(t, r)
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: consts.ProviderAddress,
+		SourceTypeName:        sourceTypeName,
+		SourceSchemaVersion:   sourceSchemaVersion,
+		SourceRawState:        &tfprotov6.RawState{JSON: readFixture(t, sourceStatePath)},
+	}
+	stateType := s.Type().TerraformType(ctx)
+	for _, mover := range r.MoveState(ctx) {
+		resp := &resource.MoveStateResponse{TargetState: tfsdk.State{Schema: s, Raw: tftypes.NewValue(stateType, nil)}}
+		mover.StateMover(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("failed to move the %s state %s: %v", sourceTypeName, sourceStatePath, resp.Diagnostics)
+		}
+		if !resp.TargetState.Raw.IsNull() {
+			checkState(t, s, resp.TargetState.Raw, expectedStatePath)
+			return
+		}
+	}
+	t.Fatalf("the resource has no state mover of %s", sourceTypeName)
+}
+
+func resourceSchema(t *testing.T, r resource.Resource) schema.Schema {
+	t.Helper()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to get the resource schema: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+func readFixture(t *testing.T, path string) []byte {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+func checkState(t *testing.T, s schema.Schema, state tftypes.Value, expectedStatePath string) {
+	t.Helper()
+	expected, err := tftypes.ValueFromJSON(readFixture(t, expectedStatePath), s.Type().TerraformType(context.Background()))
+	if err != nil {
+		t.Fatalf("the expected state %s doesn't match the resource schema: %s", expectedStatePath, err)
+	}
+	diffs, err := state.Diff(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, diff := range diffs {
+		t.Errorf("%s: expected %s, got %s", diff.Path, diff.Value2, diff.Value1)
+	}
+}
@@ -0,0 +1,44 @@
+package beta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+const enableInstructions = "Enable beta features by setting `beta_features_enabled = true` in the provider configuration or the environment variable PORT_BETA_FEATURES_ENABLED=true."
+
+// ValidateResource is called from the ModifyPlan of a resource in beta, it fails the plan unless beta features are
+// enabled in the provider. Destroy plans are allowed so that the resource can be removed after beta features are
+// disabled
+func ValidateResource(portClient *cli.PortClient, plan tfsdk.Plan, resourceTitle string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if portClient == nil || plan.Raw.IsNull() || portClient.BetaFeaturesEnabled {
+		return diags
+	}
+	diags.AddError("Beta features are not enabled", fmt.Sprintf("%s resource is currently in beta and is subject to change in future versions. %s", resourceTitle, enableInstructions))
+	return diags
+}
+
+// ValidateAttributes is called from the ModifyPlan of a resource with attributes in beta, it fails the plan for each
+// of the attributes that is set unless beta features are enabled in the provider
+func ValidateAttributes(ctx context.Context, portClient *cli.PortClient, plan tfsdk.Plan, attributePaths ...path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if portClient == nil || plan.Raw.IsNull() || portClient.BetaFeaturesEnabled {
+		return diags
+	}
+	for _, attributePath := range attributePaths {
+		var value attr.Value
+		diags.Append(plan.GetAttribute(ctx, attributePath, &value)...)
+		if value == nil || value.IsNull() {
+			continue
+		}
+		diags.AddAttributeError(attributePath, "Beta features are not enabled", fmt.Sprintf("%s is currently in beta and is subject to change in future versions. %s", attributePath, enableInstructions))
+	}
+	return diags
+}
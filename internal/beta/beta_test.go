@@ -0,0 +1,73 @@
+package beta_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/beta"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/page"
+)
+
+// pagePlan returns a plan of a port_page with the given attributes, the others are null
+func pagePlan(t *testing.T, values map[string]tftypes.Value) tfsdk.Plan {
+	ctx := context.Background()
+	resp := &resource.SchemaResponse{}
+	page.NewPageResource().Schema(ctx, resource.SchemaRequest{}, resp)
+	objectType := resp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if values == nil {
+		return tfsdk.Plan{Schema: resp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	}
+	attributes := make(map[string]tftypes.Value)
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		}
+	}
+	return tfsdk.Plan{Schema: resp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+}
+
+func TestValidateResource(t *testing.T) {
+	plan := pagePlan(t, map[string]tftypes.Value{"identifier": tftypes.NewValue(tftypes.String, "home")})
+	if diags := beta.ValidateResource(&cli.PortClient{}, plan, "Page"); !diags.HasError() || diags[0].Summary() != "Beta features are not enabled" {
+		t.Errorf("expected the plan to fail when beta features are disabled, got %v", diags)
+	}
+	if diags := beta.ValidateResource(&cli.PortClient{BetaFeaturesEnabled: true}, plan, "Page"); diags.HasError() {
+		t.Errorf("expected the plan to succeed when beta features are enabled, got %v", diags)
+	}
+	// the resource can be destroyed after beta features are disabled
+	if diags := beta.ValidateResource(&cli.PortClient{}, pagePlan(t, nil), "Page"); diags.HasError() {
+		t.Errorf("expected the destroy plan to succeed, got %v", diags)
+	}
+}
+
+func TestValidateAttributes(t *testing.T) {
+	ctx := context.Background()
+	locked := pagePlan(t, map[string]tftypes.Value{
+		"identifier": tftypes.NewValue(tftypes.String, "home"),
+		"locked":     tftypes.NewValue(tftypes.Bool, true),
+	})
+	unlocked := pagePlan(t, map[string]tftypes.Value{"identifier": tftypes.NewValue(tftypes.String, "home")})
+
+	diags := beta.ValidateAttributes(ctx, &cli.PortClient{}, locked, path.Root("locked"), path.Root("title"))
+	if len(diags) != 1 || !diags.HasError() {
+		t.Fatalf("expected an error for the attribute that is set, got %v", diags)
+	}
+	if !diags[0].(interface{ Path() path.Path }).Path().Equal(path.Root("locked")) {
+		t.Errorf("expected the error to be reported on the attribute, got %v", diags[0])
+	}
+	if diags = beta.ValidateAttributes(ctx, &cli.PortClient{}, unlocked, path.Root("locked")); diags.HasError() {
+		t.Errorf("expected no error when the attribute isn't set, got %v", diags)
+	}
+	if diags = beta.ValidateAttributes(ctx, &cli.PortClient{BetaFeaturesEnabled: true}, locked, path.Root("locked")); diags.HasError() {
+		t.Errorf("expected no error when beta features are enabled, got %v", diags)
+	}
+	if diags = beta.ValidateAttributes(ctx, &cli.PortClient{}, pagePlan(t, nil), path.Root("locked")); diags.HasError() {
+		t.Errorf("expected the destroy plan to succeed, got %v", diags)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+
+	"github.com/samber/lo"
 )
 
 func (c *PortClient) ReadAction(ctx context.Context, id string) (*Action, int, error) {
@@ -13,7 +15,7 @@ func (c *PortClient) ReadAction(ctx context.Context, id string) (*Action, int, e
 		SetContext(ctx).
 		SetHeader("Accept", "application/json").
 		SetResult(pb).
-		SetPathParam("action_identifier", id).
+		SetPathParam("action_identifier", c.Namespace.identifier(id)).
 		Get(url)
 	if err != nil {
 		return nil, resp.StatusCode(), err
@@ -21,13 +23,37 @@ func (c *PortClient) ReadAction(ctx context.Context, id string) (*Action, int, e
 	if !pb.OK {
 		return nil, resp.StatusCode(), fmt.Errorf("failed to read action, got: %s", resp.Body())
 	}
+	c.Namespace.actionFromPort(&pb.Action)
 	return &pb.Action, resp.StatusCode(), nil
 }
 
+func (c *PortClient) ReadActions(ctx context.Context) ([]Action, int, error) {
+	pb := &PortBody{}
+	url := "v1/actions"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		Get(url)
+	if err != nil {
+		return nil, resp.StatusCode(), err
+	}
+	if !pb.OK {
+		return nil, resp.StatusCode(), fmt.Errorf("failed to read actions, got: %s", resp.Body())
+	}
+	pb.Actions = lo.Filter(pb.Actions, func(a Action, _ int) bool {
+		return c.Namespace.inNamespace(a.Identifier)
+	})
+	for i := range pb.Actions {
+		c.Namespace.actionFromPort(&pb.Actions[i])
+	}
+	return pb.Actions, resp.StatusCode(), nil
+}
+
 func (c *PortClient) CreateAction(ctx context.Context, action *Action) (*Action, error) {
 	url := "v1/actions"
 	resp, err := c.Client.R().
-		SetBody(action).
+		SetBody(c.Namespace.actionToPort(action)).
 		SetContext(ctx).
 		Post(url)
 	if err != nil {
@@ -41,15 +67,16 @@ func (c *PortClient) CreateAction(ctx context.Context, action *Action) (*Action,
 	if !pb.OK {
 		return nil, fmt.Errorf("failed to create action, got: %s", resp.Body())
 	}
+	c.Namespace.actionFromPort(&pb.Action)
 	return &pb.Action, nil
 }
 
 func (c *PortClient) UpdateAction(ctx context.Context, actionID string, action *Action) (*Action, error) {
 	url := "v1/actions/{action_identifier}"
 	resp, err := c.Client.R().
-		SetBody(action).
+		SetBody(c.Namespace.actionToPort(action)).
 		SetContext(ctx).
-		SetPathParam("action_identifier", actionID).
+		SetPathParam("action_identifier", c.Namespace.identifier(actionID)).
 		Put(url)
 	if err != nil {
 		return nil, err
@@ -62,6 +89,7 @@ func (c *PortClient) UpdateAction(ctx context.Context, actionID string, action *
 	if !pb.OK {
 		return nil, fmt.Errorf("failed to create action, got: %s", resp.Body())
 	}
+	c.Namespace.actionFromPort(&pb.Action)
 	return &pb.Action, nil
 }
 
@@ -70,7 +98,7 @@ func (c *PortClient) DeleteAction(ctx context.Context, actionID string) error {
 	resp, err := c.Client.R().
 		SetContext(ctx).
 		SetHeader("Accept", "application/json").
-		SetPathParam("action_identifier", actionID).
+		SetPathParam("action_identifier", c.Namespace.identifier(actionID)).
 		Delete(url)
 	if err != nil {
 		return err
@@ -13,7 +13,7 @@ func (c *PortClient) GetActionPermissions(ctx context.Context, actionID string)
 		SetContext(ctx).
 		SetHeader("Accept", "application/json").
 		SetResult(pb).
-		SetPathParam("action_identifier", actionID).
+		SetPathParam("action_identifier", c.Namespace.identifier(actionID)).
 		Get(url)
 	if err != nil {
 		return nil, resp.StatusCode(), err
@@ -31,7 +31,7 @@ func (c *PortClient) UpdateActionPermissions(ctx context.Context, actionID strin
 	resp, err := c.Client.R().
 		SetBody(permissions).
 		SetContext(ctx).
-		SetPathParam("action_identifier", actionID).
+		SetPathParam("action_identifier", c.Namespace.identifier(actionID)).
 		Patch(url)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// SendRequest sends a request to any path of the Port API, with the authentication and the retries of the client, for
+// the API features that have no typed client. It returns the raw response body, and fails when Port doesn't return
+// an ok response.
+func (c *PortClient) SendRequest(ctx context.Context, method string, path string, body any, queryParams map[string]string) ([]byte, int, error) {
+	if err := ValidateRequestPath(path); err != nil {
+		return nil, 0, err
+	}
+	req := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetQueryParams(queryParams)
+	if body != nil {
+		req.SetHeader("Content-Type", "application/json").SetBody(body)
+	}
+	resp, err := req.Execute(method, strings.TrimPrefix(path, "/"))
+	if err != nil {
+		return nil, resp.StatusCode(), err
+	}
+	if resp.IsError() {
+		return nil, resp.StatusCode(), fmt.Errorf("failed to %s %s, got: %s", method, path, resp.Body())
+	}
+	pb := map[string]any{}
+	if err = json.Unmarshal(resp.Body(), &pb); err == nil && pb["ok"] == false {
+		return nil, resp.StatusCode(), fmt.Errorf("failed to %s %s, got: %s", method, path, resp.Body())
+	}
+	return resp.Body(), resp.StatusCode(), nil
+}
+
+// ValidateRequestPath returns an error when the path isn't relative to the API URL, resty sends the requests to
+// absolute URLs as they are, with the credentials of the client
+func ValidateRequestPath(path string) error {
+	u, err := url.Parse(path)
+	if err != nil {
+		return fmt.Errorf("the path %q isn't valid: %w", path, err)
+	}
+	if u.Scheme != "" || u.Host != "" || strings.HasPrefix(path, "//") {
+		return fmt.Errorf("the path %q must be relative to the Port API URL, without a scheme or a host", path)
+	}
+	return nil
+}
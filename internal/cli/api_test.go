@@ -0,0 +1,31 @@
+package cli_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSendRequestRejectsOtherHosts(t *testing.T) {
+	_, portClient := newClients(t)
+	requested := false
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	t.Cleanup(other.Close)
+
+	for _, path := range []string{other.URL + "/v1/blueprints", "//" + strings.TrimPrefix(other.URL, "http://") + "/v1/blueprints"} {
+		if _, _, err := portClient.SendRequest(context.Background(), http.MethodGet, path, nil, nil); err == nil || !strings.Contains(err.Error(), "must be relative to the Port API URL") {
+			t.Errorf("expected the request to %s to be rejected, got %v", path, err)
+		}
+	}
+	if requested {
+		t.Error("expected no request to be sent to the other host")
+	}
+
+	if _, _, err := portClient.SendRequest(context.Background(), http.MethodGet, "/v1/blueprints", nil, nil); err != nil {
+		t.Errorf("expected a request to a path of the API to be sent, got %s", err)
+	}
+}
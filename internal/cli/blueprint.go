@@ -4,6 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+
+	"github.com/samber/lo"
 )
 
 func (c *PortClient) ReadBlueprint(ctx context.Context, id string) (*Blueprint, int, error) {
@@ -14,7 +19,7 @@ func (c *PortClient) ReadBlueprint(ctx context.Context, id string) (*Blueprint,
 		SetHeader("Accept", "application/json").
 		SetQueryParam("exclude_calculated_properties", "true").
 		SetResult(pb).
-		SetPathParam("identifier", id).
+		SetPathParam("identifier", c.Namespace.identifier(id)).
 		Get(url)
 	if err != nil {
 		return nil, resp.StatusCode(), err
@@ -22,13 +27,69 @@ func (c *PortClient) ReadBlueprint(ctx context.Context, id string) (*Blueprint,
 	if !pb.OK {
 		return nil, resp.StatusCode(), fmt.Errorf("failed to read blueprint, got: %s", resp.Body())
 	}
+	c.Namespace.blueprintFromPort(&pb.Blueprint)
 	return &pb.Blueprint, resp.StatusCode(), nil
 }
 
+type (
+	blueprintRead struct {
+		blueprint  *Blueprint
+		statusCode int
+		err        error
+	}
+	blueprintReads struct {
+		mu    sync.Mutex
+		reads map[string]blueprintRead
+	}
+)
+
+// ReadPlannedBlueprint reads the blueprint for the plan validations of the resources that depend on it, the blueprint
+// is read once per plan. A blueprint that doesn't exist is cached too, other errors aren't.
+func (c *PortClient) ReadPlannedBlueprint(ctx context.Context, id string) (*Blueprint, int, error) {
+	c.plannedBlueprints.mu.Lock()
+	read, ok := c.plannedBlueprints.reads[id]
+	c.plannedBlueprints.mu.Unlock()
+	if ok {
+		return read.blueprint, read.statusCode, read.err
+	}
+
+	b, statusCode, err := c.ReadBlueprint(ctx, id)
+	if err == nil || statusCode == 404 {
+		c.plannedBlueprints.mu.Lock()
+		c.plannedBlueprints.reads[id] = blueprintRead{blueprint: b, statusCode: statusCode, err: err}
+		c.plannedBlueprints.mu.Unlock()
+	}
+	return b, statusCode, err
+}
+
+func (c *PortClient) ReadBlueprints(ctx context.Context) ([]Blueprint, int, error) {
+	pb := &PortBody{}
+	url := "v1/blueprints"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetQueryParam("exclude_calculated_properties", "true").
+		SetResult(pb).
+		Get(url)
+	if err != nil {
+		return nil, resp.StatusCode(), err
+	}
+	if !pb.OK {
+		return nil, resp.StatusCode(), fmt.Errorf("failed to read blueprints, got: %s", resp.Body())
+	}
+	pb.Blueprints = lo.Filter(pb.Blueprints, func(b Blueprint, _ int) bool {
+		return c.Namespace.inNamespace(b.Identifier)
+	})
+	for i := range pb.Blueprints {
+		c.Namespace.blueprintFromPort(&pb.Blueprints[i])
+	}
+	return pb.Blueprints, resp.StatusCode(), nil
+}
+
 func (c *PortClient) CreateBlueprint(ctx context.Context, b *Blueprint, createCatalogPage *bool) (*Blueprint, error) {
 	url := "v1/blueprints"
 	request := c.Client.R().
-		SetBody(b).
+		SetBody(c.Namespace.blueprintToPort(b)).
 		SetContext(ctx)
 	if createCatalogPage != nil {
 		request.SetQueryParam("create_catalog_page", fmt.Sprintf("%t", *createCatalogPage))
@@ -45,15 +106,16 @@ func (c *PortClient) CreateBlueprint(ctx context.Context, b *Blueprint, createCa
 	if !pb.OK {
 		return nil, fmt.Errorf("failed to create blueprint, got: %s", resp.Body())
 	}
+	c.Namespace.blueprintFromPort(&pb.Blueprint)
 	return &pb.Blueprint, nil
 }
 
 func (c *PortClient) UpdateBlueprint(ctx context.Context, b *Blueprint, id string) (*Blueprint, error) {
 	url := "v1/blueprints/{identifier}"
 	resp, err := c.Client.R().
-		SetBody(b).
+		SetBody(c.Namespace.blueprintToPort(b)).
 		SetContext(ctx).
-		SetPathParam("identifier", id).
+		SetPathParam("identifier", c.Namespace.identifier(id)).
 		Put(url)
 	if err != nil {
 		return nil, err
@@ -66,15 +128,88 @@ func (c *PortClient) UpdateBlueprint(ctx context.Context, b *Blueprint, id strin
 	if !pb.OK {
 		return nil, fmt.Errorf("failed to create blueprint, got: %s", resp.Body())
 	}
+	c.Namespace.blueprintFromPort(&pb.Blueprint)
 	return &pb.Blueprint, nil
 }
 
+// ReadBlueprintJSON reads the blueprint as Port returns it, with the fields that Blueprint doesn't have and the empty
+// ones that it omits
+func (c *PortClient) ReadBlueprintJSON(ctx context.Context, id string) (map[string]any, int, error) {
+	url := "v1/blueprints/{identifier}"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetQueryParam("exclude_calculated_properties", "true").
+		SetPathParam("identifier", c.Namespace.identifier(id)).
+		Get(url)
+	if err != nil {
+		return nil, resp.StatusCode(), err
+	}
+	b, ok := c.blueprintJSONFromResponse(resp.Body())
+	if !ok {
+		return nil, resp.StatusCode(), fmt.Errorf("failed to read blueprint, got: %s", resp.Body())
+	}
+	return b, resp.StatusCode(), nil
+}
+
+// CreateBlueprintJSON creates the blueprint from a JSON object, the fields are sent as they are
+func (c *PortClient) CreateBlueprintJSON(ctx context.Context, b map[string]any, createCatalogPage *bool) (map[string]any, error) {
+	url := "v1/blueprints"
+	request := c.Client.R().
+		SetBody(c.Namespace.blueprintJSONToPort(b)).
+		SetContext(ctx)
+	if createCatalogPage != nil {
+		request.SetQueryParam("create_catalog_page", fmt.Sprintf("%t", *createCatalogPage))
+	}
+	resp, err := request.Post(url)
+	if err != nil {
+		return nil, err
+	}
+	created, ok := c.blueprintJSONFromResponse(resp.Body())
+	if !ok {
+		return nil, fmt.Errorf("failed to create blueprint, got: %s", resp.Body())
+	}
+	return created, nil
+}
+
+// UpdateBlueprintJSON replaces the blueprint with a JSON object, the fields are sent as they are
+func (c *PortClient) UpdateBlueprintJSON(ctx context.Context, b map[string]any, id string) (map[string]any, error) {
+	url := "v1/blueprints/{identifier}"
+	resp, err := c.Client.R().
+		SetBody(c.Namespace.blueprintJSONToPort(b)).
+		SetContext(ctx).
+		SetPathParam("identifier", c.Namespace.identifier(id)).
+		Put(url)
+	if err != nil {
+		return nil, err
+	}
+	updated, ok := c.blueprintJSONFromResponse(resp.Body())
+	if !ok {
+		return nil, fmt.Errorf("failed to update blueprint, got: %s", resp.Body())
+	}
+	return updated, nil
+}
+
+// blueprintJSONFromResponse returns the blueprint of an ok response body, false for any other body
+func (c *PortClient) blueprintJSONFromResponse(body []byte) (map[string]any, bool) {
+	decoded, err := utils.DecodeJSON(body)
+	if err != nil {
+		return nil, false
+	}
+	pb, _ := decoded.(map[string]any)
+	b, isObject := pb["blueprint"].(map[string]any)
+	if pb["ok"] != true || !isObject {
+		return nil, false
+	}
+	return c.Namespace.blueprintJSONFromPort(b), true
+}
+
 func (c *PortClient) DeleteBlueprint(ctx context.Context, id string) error {
 	url := "v1/blueprints/{identifier}"
 	resp, err := c.Client.R().
 		SetContext(ctx).
 		SetHeader("Accept", "application/json").
-		SetPathParam("identifier", id).
+		SetPathParam("identifier", c.Namespace.identifier(id)).
 		Delete(url)
 	if err != nil {
 		return err
@@ -95,7 +230,7 @@ func (c *PortClient) DeleteBlueprintWithAllEntities(ctx context.Context, id stri
 	resp, err := c.Client.R().
 		SetContext(ctx).
 		SetHeader("Accept", "application/json").
-		SetPathParam("identifier", id).
+		SetPathParam("identifier", c.Namespace.identifier(id)).
 		Delete(url)
 	if err != nil {
 		return nil, err
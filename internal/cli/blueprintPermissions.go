@@ -13,7 +13,7 @@ func (c *PortClient) GetBlueprintPermissions(ctx context.Context, blueprintID st
 		SetContext(ctx).
 		SetHeader("Accept", "application/json").
 		SetResult(pppb).
-		SetPathParam("blueprint_identifier", blueprintID).
+		SetPathParam("blueprint_identifier", c.Namespace.identifier(blueprintID)).
 		Get(url)
 	if err != nil {
 		return nil, resp.StatusCode(), err
@@ -31,7 +31,7 @@ func (c *PortClient) UpdateBlueprintPermissions(ctx context.Context, blueprintID
 	resp, err := c.Client.R().
 		SetBody(permissions).
 		SetContext(ctx).
-		SetPathParam("blueprint_identifier", blueprintID).
+		SetPathParam("blueprint_identifier", c.Namespace.identifier(blueprintID)).
 		Patch(url)
 	if err != nil {
 		return nil, err
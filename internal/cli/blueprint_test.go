@@ -0,0 +1,41 @@
+package cli_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+func TestReadPlannedBlueprintReadsOncePerPlan(t *testing.T) {
+	_, portClient := newClients(t)
+	ctx := context.Background()
+
+	if _, statusCode, err := portClient.ReadPlannedBlueprint(ctx, "service"); err == nil || statusCode != 404 {
+		t.Fatalf("expected the blueprint not to exist, got %d: %v", statusCode, err)
+	}
+	if _, err := portClient.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "service", Title: "Service"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	// a blueprint that doesn't exist is cached too
+	if _, statusCode, _ := portClient.ReadPlannedBlueprint(ctx, "service"); statusCode != 404 {
+		t.Errorf("expected the blueprint to be read once, got %d", statusCode)
+	}
+
+	if _, err := portClient.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "domain", Title: "Domain"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	b, _, err := portClient.ReadPlannedBlueprint(ctx, "domain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = portClient.UpdateBlueprint(ctx, &cli.Blueprint{Identifier: "domain", Title: "Domain Updated"}, "domain"); err != nil {
+		t.Fatal(err)
+	}
+	if b, _, _ = portClient.ReadPlannedBlueprint(ctx, "domain"); b.Title != "Domain" {
+		t.Errorf("expected the blueprint to be read once, got %s", b.Title)
+	}
+	if b, _, _ = portClient.ReadBlueprint(ctx, "domain"); b.Title != "Domain Updated" {
+		t.Errorf("expected ReadBlueprint to read the blueprint in Port, got %s", b.Title)
+	}
+}
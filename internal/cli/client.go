@@ -3,9 +3,11 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 	"strings"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 type (
@@ -14,11 +16,31 @@ type (
 		Client   *resty.Client
 		ClientID string
 		Token    string
+		// BetaFeaturesEnabled is set from the provider configuration, resources in beta can only be used when it is set
+		BetaFeaturesEnabled bool
+		// ValidateSecretReferences is set from the provider configuration, the plans of actions check that the secrets
+		// their payloads reference exist in the organization when it is set
+		ValidateSecretReferences bool
+		// Namespace is set from the provider configuration, it is applied to the objects sent to and read from Port
+		Namespace Namespace
+		// PendingBlueprints and PendingEntities, keyed by <blueprint>:<identifier>, have changes in the current plan,
+		// the plan validations against the objects in Port don't fail for them as the objects change in the same apply
+		PendingBlueprints *utils.KeySet
+		PendingEntities   *utils.KeySet
+		// PendingScorecards, keyed by <blueprint>:<identifier>, have changes in the current plan, the levels of their
+		// rules aren't validated against the levels in Port
+		PendingScorecards *utils.KeySet
+		// plannedBlueprints are the blueprints read by the plan validations, each blueprint is read once per plan
+		plannedBlueprints *blueprintReads
 	}
 )
 
 func New(baseURL string, opts ...Option) (*PortClient, error) {
 	c := &PortClient{
+		PendingBlueprints: &utils.KeySet{},
+		PendingEntities:   &utils.KeySet{},
+		PendingScorecards: &utils.KeySet{},
+		plannedBlueprints: &blueprintReads{reads: make(map[string]blueprintRead)},
 		Client: resty.New().
 			SetBaseURL(baseURL).
 			SetRetryCount(5).
@@ -69,6 +91,15 @@ func WithHeader(key, val string) Option {
 	}
 }
 
+// WithTransport sends the requests of the client through transport, a nil transport keeps the default one
+func WithTransport(transport http.RoundTripper) Option {
+	return func(pc *PortClient) {
+		if transport != nil {
+			pc.Client.SetTransport(transport)
+		}
+	}
+}
+
 func WithClientID(clientID string) Option {
 	return func(pc *PortClient) {
 		pc.ClientID = clientID
@@ -80,3 +111,15 @@ func WithToken(token string) Option {
 		pc.Client.SetAuthToken(token)
 	}
 }
+
+func WithBetaFeaturesEnabled(enabled bool) Option {
+	return func(pc *PortClient) {
+		pc.BetaFeaturesEnabled = enabled
+	}
+}
+
+func WithValidateSecretReferences(enabled bool) Option {
+	return func(pc *PortClient) {
+		pc.ValidateSecretReferences = enabled
+	}
+}
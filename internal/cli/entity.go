@@ -13,7 +13,7 @@ func (c *PortClient) ReadEntity(ctx context.Context, id string, blueprint string
 		// we don't want to include those properties as they are calculated by the backend
 		// and not part of the state, pulling them would cause a diff
 		SetQueryParam("exclude_calculated_properties", "true").
-		SetPathParam(("blueprint"), blueprint).
+		SetPathParam("blueprint", c.Namespace.identifier(blueprint)).
 		SetPathParam("identifier", id).
 		Get(url)
 	if err != nil {
@@ -27,6 +27,7 @@ func (c *PortClient) ReadEntity(ctx context.Context, id string, blueprint string
 	if !pb.OK {
 		return nil, resp.StatusCode(), fmt.Errorf("failed to read entity, got: %s", resp.Body())
 	}
+	c.Namespace.entityFromPort(&pb.Entity)
 	return &pb.Entity, resp.StatusCode(), nil
 }
 
@@ -34,8 +35,8 @@ func (c *PortClient) CreateEntity(ctx context.Context, e *Entity, runID string)
 	url := "v1/blueprints/{blueprint}/entities"
 	pb := &PortBody{}
 	resp, err := c.Client.R().
-		SetBody(e).
-		SetPathParam(("blueprint"), e.Blueprint).
+		SetBody(c.Namespace.entityToPort(e)).
+		SetPathParam("blueprint", c.Namespace.identifier(e.Blueprint)).
 		SetQueryParam("upsert", "true").
 		SetQueryParam("run_id", runID).
 		SetResult(&pb).
@@ -46,6 +47,7 @@ func (c *PortClient) CreateEntity(ctx context.Context, e *Entity, runID string)
 	if !pb.OK {
 		return nil, fmt.Errorf("failed to create entity, got: %s", resp.Body())
 	}
+	c.Namespace.entityFromPort(&pb.Entity)
 	return &pb.Entity, nil
 }
 
@@ -53,8 +55,8 @@ func (c *PortClient) UpdateEntity(ctx context.Context, id string, blueprint stri
 	url := "v1/blueprints/{blueprint}/entities/{identifier}"
 	pb := &PortBody{}
 	resp, err := c.Client.R().
-		SetBody(e).
-		SetPathParam(("blueprint"), e.Blueprint).
+		SetBody(c.Namespace.entityToPort(e)).
+		SetPathParam("blueprint", c.Namespace.identifier(e.Blueprint)).
 		SetPathParam("identifier", id).
 		SetQueryParam("run_id", runID).
 		SetResult(&pb).
@@ -65,6 +67,7 @@ func (c *PortClient) UpdateEntity(ctx context.Context, id string, blueprint stri
 	if !pb.OK {
 		return nil, fmt.Errorf("failed to update entity, got: %s", resp.Body())
 	}
+	c.Namespace.entityFromPort(&pb.Entity)
 	return &pb.Entity, nil
 }
 
@@ -73,7 +76,7 @@ func (c *PortClient) DeleteEntity(ctx context.Context, id string, blueprint stri
 	pb := &PortBody{}
 	resp, err := c.Client.R().
 		SetHeader("Accept", "application/json").
-		SetPathParam("blueprint", blueprint).
+		SetPathParam("blueprint", c.Namespace.identifier(blueprint)).
 		SetPathParam("identifier", id).
 		SetResult(pb).
 		Delete(url)
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ReadFolder looks the folder up in the items of its sidebar, as Port doesn't expose an endpoint to get a single folder
+func (c *PortClient) ReadFolder(ctx context.Context, sidebarId string, folderId string) (*Folder, int, error) {
+	pb := &PortBody{}
+	url := "v1/sidebars/{sidebar_identifier}"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		SetPathParam("sidebar_identifier", sidebarId).
+		Get(url)
+	if err != nil {
+		return nil, resp.StatusCode(), err
+	}
+	if !pb.OK {
+		return nil, resp.StatusCode(), fmt.Errorf("failed to read sidebar, got: %s", resp.Body())
+	}
+	for _, item := range pb.Sidebar.Items {
+		if item.SidebarType == "folder" && item.Identifier == c.Namespace.identifier(folderId) {
+			folder := item
+			folder.Sidebar = sidebarId
+			c.Namespace.folderFromPort(&folder)
+			return &folder, resp.StatusCode(), nil
+		}
+	}
+	return nil, http.StatusNotFound, fmt.Errorf("folder %s not found in sidebar %s", folderId, sidebarId)
+}
+
+func (c *PortClient) CreateFolder(ctx context.Context, sidebarId string, folder *Folder) (*Folder, error) {
+	url := "v1/sidebars/{sidebar_identifier}/folders"
+	resp, err := c.Client.R().
+		SetBody(c.Namespace.folderToPort(folder)).
+		SetContext(ctx).
+		SetPathParam("sidebar_identifier", sidebarId).
+		Post(url)
+	if err != nil {
+		return nil, err
+	}
+	var pb PortBody
+	err = json.Unmarshal(resp.Body(), &pb)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to create folder, got: %s", resp.Body())
+	}
+	c.Namespace.folderFromPort(&pb.Folder)
+	return &pb.Folder, nil
+}
+
+func (c *PortClient) UpdateFolder(ctx context.Context, sidebarId string, folderId string, folder *Folder) (*Folder, error) {
+	url := "v1/sidebars/{sidebar_identifier}/folders/{folder_identifier}"
+	resp, err := c.Client.R().
+		SetBody(c.Namespace.folderToPort(folder)).
+		SetContext(ctx).
+		SetPathParam("sidebar_identifier", sidebarId).
+		SetPathParam("folder_identifier", c.Namespace.identifier(folderId)).
+		Patch(url)
+	if err != nil {
+		return nil, err
+	}
+	var pb PortBody
+	err = json.Unmarshal(resp.Body(), &pb)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to update folder, got: %s", resp.Body())
+	}
+	c.Namespace.folderFromPort(&pb.Folder)
+	return &pb.Folder, nil
+}
+
+func (c *PortClient) DeleteFolder(ctx context.Context, sidebarId string, folderId string) (int, error) {
+	url := "v1/sidebars/{sidebar_identifier}/folders/{folder_identifier}"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetPathParam("sidebar_identifier", sidebarId).
+		SetPathParam("folder_identifier", c.Namespace.identifier(folderId)).
+		Delete(url)
+	if err != nil {
+		return resp.StatusCode(), err
+	}
+	var pb PortBody
+	err = json.Unmarshal(resp.Body(), &pb)
+	if err != nil {
+		return resp.StatusCode(), err
+	}
+	if !pb.OK {
+		return resp.StatusCode(), fmt.Errorf("failed to delete folder, got: %s", resp.Body())
+	}
+	return resp.StatusCode(), nil
+}
@@ -298,6 +298,47 @@ type (
 		Description *string           `json:"description,omitempty"`
 	}
 
+	Folder struct {
+		Meta
+		Identifier  string  `json:"identifier,omitempty"`
+		Sidebar     string  `json:"sidebar,omitempty"`
+		Title       *string `json:"title,omitempty"`
+		Parent      *string `json:"parent,omitempty"`
+		After       *string `json:"after,omitempty"`
+		SidebarType string  `json:"sidebarType,omitempty"`
+	}
+
+	Sidebar struct {
+		Identifier string   `json:"identifier,omitempty"`
+		Items      []Folder `json:"items,omitempty"`
+	}
+
+	Organization struct {
+		Meta
+		Id       string                `json:"id,omitempty"`
+		Name     string                `json:"name,omitempty"`
+		Settings *OrganizationSettings `json:"settings,omitempty"`
+	}
+
+	OrganizationSettings struct {
+		PortalTitle      *string                   `json:"portalTitle,omitempty"`
+		PortalIcon       *string                   `json:"portalIcon,omitempty"`
+		Announcement     *OrganizationAnnouncement `json:"announcement,omitempty"`
+		HiddenBlueprints *[]string                 `json:"hiddenBlueprints,omitempty"`
+		FeatureToggles   *map[string]bool          `json:"featureToggles,omitempty"`
+	}
+
+	OrganizationAnnouncement struct {
+		Enabled *bool   `json:"enabled,omitempty"`
+		Content *string `json:"content,omitempty"`
+		Link    *string `json:"link,omitempty"`
+	}
+
+	OrganizationSecret struct {
+		SecretName  string  `json:"secretName"`
+		Description *string `json:"description,omitempty"`
+	}
+
 	PageReadPermissions struct {
 		Users []string `json:"users"`
 		Roles []string `json:"roles"`
@@ -432,18 +473,28 @@ type (
 )
 
 type PortBody struct {
-	OK                   bool              `json:"ok"`
-	Entity               Entity            `json:"entity"`
-	Blueprint            Blueprint         `json:"blueprint"`
-	BlueprintPermissions Blueprint         `json:"blueprint_permissions"`
-	Action               Action            `json:"action"`
-	ActionPermissions    ActionPermissions `json:"permissions"`
-	Webhook              Webhook           `json:"integration"`
-	Scorecard            Scorecard         `json:"Scorecard"`
-	Team                 Team              `json:"team"`
-	Page                 Page              `json:"page"`
-	MigrationId          string            `json:"migrationId"`
-	Migration            Migration         `json:"migration"`
+	OK                   bool                 `json:"ok"`
+	Entity               Entity               `json:"entity"`
+	Blueprint            Blueprint            `json:"blueprint"`
+	BlueprintPermissions Blueprint            `json:"blueprint_permissions"`
+	Action               Action               `json:"action"`
+	ActionPermissions    ActionPermissions    `json:"permissions"`
+	Webhook              Webhook              `json:"integration"`
+	Scorecard            Scorecard            `json:"Scorecard"`
+	Team                 Team                 `json:"team"`
+	Page                 Page                 `json:"page"`
+	Folder               Folder               `json:"folder"`
+	Sidebar              Sidebar              `json:"sidebar"`
+	Organization         Organization         `json:"organization"`
+	Secrets              []OrganizationSecret `json:"secrets"`
+	MigrationId          string               `json:"migrationId"`
+	Migration            Migration            `json:"migration"`
+	Blueprints           []Blueprint          `json:"blueprints"`
+	Actions              []Action             `json:"actions"`
+	Webhooks             []Webhook            `json:"integrations"`
+	Scorecards           []Scorecard          `json:"scorecards"`
+	Teams                []Team               `json:"teams"`
+	Pages                []Page               `json:"pages"`
 }
 
 type SearchEntityResult struct {
@@ -462,6 +513,12 @@ type SearchResult struct {
 	Entities           []Entity `json:"entities"`
 }
 
+type BlueprintEntitiesSearchResult struct {
+	OK       bool     `json:"ok"`
+	Entities []Entity `json:"entities"`
+	Next     *string  `json:"next"`
+}
+
 type PortPagePermissionsBody struct {
 	OK              bool            `json:"ok"`
 	PagePermissions PagePermissions `json:"permissions"`
@@ -491,10 +548,14 @@ type PortTeamBody struct {
 }
 
 type PortProviderModel struct {
-	ClientId types.String `tfsdk:"client_id"`
-	Secret   types.String `tfsdk:"secret"`
-	Token    types.String `tfsdk:"token"`
-	BaseUrl  types.String `tfsdk:"base_url"`
+	ClientId                 types.String `tfsdk:"client_id"`
+	Secret                   types.String `tfsdk:"secret"`
+	Token                    types.String `tfsdk:"token"`
+	BaseUrl                  types.String `tfsdk:"base_url"`
+	BetaFeaturesEnabled      types.Bool   `tfsdk:"beta_features_enabled"`
+	IdentifierPrefix         types.String `tfsdk:"identifier_prefix"`
+	TitlePrefix              types.String `tfsdk:"title_prefix"`
+	ValidateSecretReferences types.Bool   `tfsdk:"validate_secret_references"`
 }
 
 type PortBodyDelete struct {
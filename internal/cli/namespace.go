@@ -0,0 +1,423 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// Namespace prefixes the identifiers and the titles the client sends to Port, so that the same configuration can be
+// applied to one organization for several environments, and strips the prefixes from the objects it reads back.
+// Blueprint identifiers are prefixed wherever they are referenced, e.g. in relation targets, action triggers and the
+// blueprint fields of page widgets, and so are the page and folder identifiers that sidebar items are placed under or
+// after. The relation identifiers, and so the mirror property paths and the team inheritance paths, are local to a
+// blueprint and are sent as they are. The identifiers of Port's system blueprints, e.g. _user and _team, are never
+// prefixed.
+type Namespace struct {
+	IdentifierPrefix string
+	TitlePrefix      string
+}
+
+// WithNamespace prefixes the identifiers and the titles of the blueprints, actions, scorecards, webhooks, pages and
+// folders
+func WithNamespace(identifierPrefix, titlePrefix string) Option {
+	return func(pc *PortClient) {
+		pc.Namespace = Namespace{IdentifierPrefix: identifierPrefix, TitlePrefix: titlePrefix}
+	}
+}
+
+// systemIdentifierPrefix starts the identifiers of the blueprints Port creates in every organization
+const systemIdentifierPrefix = "_"
+
+func (n Namespace) isEmpty() bool {
+	return n.IdentifierPrefix == "" && n.TitlePrefix == ""
+}
+
+func (n Namespace) identifier(id string) string {
+	if id == "" || strings.HasPrefix(id, systemIdentifierPrefix) {
+		return id
+	}
+	return n.IdentifierPrefix + id
+}
+
+// inNamespace reports whether an identifier read from Port belongs to the namespace, the lists read from Port only
+// keep the objects of the namespace, as the objects of the other environments are in the same organization. Port's
+// system blueprints belong to every namespace.
+func (n Namespace) inNamespace(id string) bool {
+	return strings.HasPrefix(id, n.IdentifierPrefix) || strings.HasPrefix(id, systemIdentifierPrefix)
+}
+
+func (n Namespace) stripIdentifier(id string) string {
+	return strings.TrimPrefix(id, n.IdentifierPrefix)
+}
+
+func (n Namespace) title(title string) string {
+	if title == "" {
+		return title
+	}
+	return n.TitlePrefix + title
+}
+
+func (n Namespace) stripTitle(title string) string {
+	return strings.TrimPrefix(title, n.TitlePrefix)
+}
+
+func (n Namespace) identifierPointer(id *string) *string {
+	if id == nil {
+		return nil
+	}
+	return lo.ToPtr(n.identifier(*id))
+}
+
+func (n Namespace) stripIdentifierPointer(id *string) *string {
+	if id == nil {
+		return nil
+	}
+	return lo.ToPtr(n.stripIdentifier(*id))
+}
+
+func (n Namespace) titlePointer(title *string) *string {
+	if title == nil {
+		return nil
+	}
+	return lo.ToPtr(n.title(*title))
+}
+
+func (n Namespace) stripTitlePointer(title *string) *string {
+	if title == nil {
+		return nil
+	}
+	return lo.ToPtr(n.stripTitle(*title))
+}
+
+// blueprintToPort returns a copy of the blueprint with the prefixes, the blueprint of the caller isn't changed
+func (n Namespace) blueprintToPort(b *Blueprint) *Blueprint {
+	if n.isEmpty() || b == nil {
+		return b
+	}
+	namespaced := *b
+	namespaced.Identifier = n.identifier(b.Identifier)
+	namespaced.Title = n.title(b.Title)
+	if b.Schema.Properties != nil {
+		namespaced.Schema.Properties = lo.MapValues(b.Schema.Properties, func(property BlueprintProperty, _ string) BlueprintProperty {
+			property.Blueprint = n.identifierPointer(property.Blueprint)
+			return property
+		})
+	}
+	if b.Relations != nil {
+		namespaced.Relations = lo.MapValues(b.Relations, func(relation Relation, _ string) Relation {
+			relation.Target = n.identifierPointer(relation.Target)
+			return relation
+		})
+	}
+	if b.AggregationProperties != nil {
+		namespaced.AggregationProperties = lo.MapValues(b.AggregationProperties, func(property BlueprintAggregationProperty, _ string) BlueprintAggregationProperty {
+			property.Target = n.identifier(property.Target)
+			return property
+		})
+	}
+	return &namespaced
+}
+
+func (n Namespace) blueprintFromPort(b *Blueprint) {
+	if n.isEmpty() || b == nil {
+		return
+	}
+	b.Identifier = n.stripIdentifier(b.Identifier)
+	b.Title = n.stripTitle(b.Title)
+	for key, property := range b.Schema.Properties {
+		property.Blueprint = n.stripIdentifierPointer(property.Blueprint)
+		b.Schema.Properties[key] = property
+	}
+	for key, relation := range b.Relations {
+		relation.Target = n.stripIdentifierPointer(relation.Target)
+		b.Relations[key] = relation
+	}
+	for key, property := range b.AggregationProperties {
+		property.Target = n.stripIdentifier(property.Target)
+		b.AggregationProperties[key] = property
+	}
+}
+
+// blueprintJSONToPort returns a copy of the blueprint JSON object with the prefixes, the object of the caller isn't
+// changed
+func (n Namespace) blueprintJSONToPort(b map[string]any) map[string]any {
+	if n.isEmpty() || b == nil {
+		return b
+	}
+	return rewriteBlueprintJSON(b, n.identifier, n.title)
+}
+
+// blueprintJSONFromPort returns a copy of the blueprint JSON object without the prefixes
+func (n Namespace) blueprintJSONFromPort(b map[string]any) map[string]any {
+	if n.isEmpty() || b == nil {
+		return b
+	}
+	return rewriteBlueprintJSON(b, n.stripIdentifier, n.stripTitle)
+}
+
+// rewriteBlueprintJSON returns a copy of the blueprint JSON object with its identifier, its title and the blueprint
+// identifiers it references rewritten, the fields that aren't of the expected type are left as they are
+func rewriteBlueprintJSON(b map[string]any, identifier func(string) string, title func(string) string) map[string]any {
+	rewritten := lo.Assign(b)
+	if id, ok := b["identifier"].(string); ok {
+		rewritten["identifier"] = identifier(id)
+	}
+	if t, ok := b["title"].(string); ok {
+		rewritten["title"] = title(t)
+	}
+	if relations, ok := b["relations"]; ok {
+		rewritten["relations"] = rewriteJSONItemsField(relations, "target", identifier)
+	}
+	if aggregationProperties, ok := b["aggregationProperties"]; ok {
+		rewritten["aggregationProperties"] = rewriteJSONItemsField(aggregationProperties, "target", identifier)
+	}
+	if schema, ok := b["schema"].(map[string]any); ok {
+		if properties, ok := schema["properties"]; ok {
+			schema = lo.Assign(schema)
+			schema["properties"] = rewriteJSONItemsField(properties, "blueprint", identifier)
+			rewritten["schema"] = schema
+		}
+	}
+	return rewritten
+}
+
+// rewriteJSONItemsField returns a copy of the JSON object of objects with the string field of each object rewritten
+func rewriteJSONItemsField(items any, field string, rewrite func(string) string) any {
+	o, ok := items.(map[string]any)
+	if !ok {
+		return items
+	}
+	return lo.MapValues(o, func(item any, _ string) any {
+		i, ok := item.(map[string]any)
+		if !ok {
+			return item
+		}
+		value, ok := i[field].(string)
+		if !ok {
+			return item
+		}
+		i = lo.Assign(i)
+		i[field] = rewrite(value)
+		return i
+	})
+}
+
+// actionToPort returns a copy of the action with the prefixes, the action of the caller isn't changed
+func (n Namespace) actionToPort(a *Action) *Action {
+	if n.isEmpty() || a == nil {
+		return a
+	}
+	namespaced := *a
+	namespaced.Identifier = n.identifier(a.Identifier)
+	namespaced.Title = n.titlePointer(a.Title)
+	if a.Trigger != nil {
+		trigger := *a.Trigger
+		trigger.BlueprintIdentifier = n.identifierPointer(trigger.BlueprintIdentifier)
+		if trigger.Event != nil {
+			event := *trigger.Event
+			event.BlueprintIdentifier = n.identifierPointer(event.BlueprintIdentifier)
+			event.ActionIdentifier = n.identifierPointer(event.ActionIdentifier)
+			trigger.Event = &event
+		}
+		if trigger.UserInputs != nil {
+			userInputs := *trigger.UserInputs
+			if userInputs.Properties != nil {
+				userInputs.Properties = lo.MapValues(userInputs.Properties, func(property ActionProperty, _ string) ActionProperty {
+					property.Blueprint = n.identifierPointer(property.Blueprint)
+					if property.Dataset != nil {
+						dataset := *property.Dataset
+						dataset.Rules = lo.Map(dataset.Rules, func(rule DatasetRule, _ int) DatasetRule {
+							rule.Blueprint = n.identifierPointer(rule.Blueprint)
+							return rule
+						})
+						property.Dataset = &dataset
+					}
+					return property
+				})
+			}
+			trigger.UserInputs = &userInputs
+		}
+		namespaced.Trigger = &trigger
+	}
+	if a.InvocationMethod != nil {
+		invocationMethod := *a.InvocationMethod
+		invocationMethod.BlueprintIdentifier = n.identifierPointer(invocationMethod.BlueprintIdentifier)
+		namespaced.InvocationMethod = &invocationMethod
+	}
+	return &namespaced
+}
+
+func (n Namespace) actionFromPort(a *Action) {
+	if n.isEmpty() || a == nil {
+		return
+	}
+	a.Identifier = n.stripIdentifier(a.Identifier)
+	a.Title = n.stripTitlePointer(a.Title)
+	if a.Trigger != nil {
+		a.Trigger.BlueprintIdentifier = n.stripIdentifierPointer(a.Trigger.BlueprintIdentifier)
+		if a.Trigger.Event != nil {
+			a.Trigger.Event.BlueprintIdentifier = n.stripIdentifierPointer(a.Trigger.Event.BlueprintIdentifier)
+			a.Trigger.Event.ActionIdentifier = n.stripIdentifierPointer(a.Trigger.Event.ActionIdentifier)
+		}
+		if a.Trigger.UserInputs != nil {
+			for key, property := range a.Trigger.UserInputs.Properties {
+				property.Blueprint = n.stripIdentifierPointer(property.Blueprint)
+				if property.Dataset != nil {
+					for i := range property.Dataset.Rules {
+						property.Dataset.Rules[i].Blueprint = n.stripIdentifierPointer(property.Dataset.Rules[i].Blueprint)
+					}
+				}
+				a.Trigger.UserInputs.Properties[key] = property
+			}
+		}
+	}
+	if a.InvocationMethod != nil {
+		a.InvocationMethod.BlueprintIdentifier = n.stripIdentifierPointer(a.InvocationMethod.BlueprintIdentifier)
+	}
+}
+
+// scorecardToPort returns a copy of the scorecard with the prefixes, the scorecard of the caller isn't changed
+func (n Namespace) scorecardToPort(s *Scorecard) *Scorecard {
+	if n.isEmpty() || s == nil {
+		return s
+	}
+	namespaced := *s
+	namespaced.Identifier = n.identifier(s.Identifier)
+	namespaced.Title = n.title(s.Title)
+	namespaced.Blueprint = n.identifier(s.Blueprint)
+	return &namespaced
+}
+
+func (n Namespace) scorecardFromPort(s *Scorecard) {
+	if n.isEmpty() || s == nil {
+		return
+	}
+	s.Identifier = n.stripIdentifier(s.Identifier)
+	s.Title = n.stripTitle(s.Title)
+	s.Blueprint = n.stripIdentifier(s.Blueprint)
+}
+
+// webhookToPort returns a copy of the webhook with the prefixes, the webhook of the caller isn't changed
+func (n Namespace) webhookToPort(w *Webhook) *Webhook {
+	if n.isEmpty() || w == nil {
+		return w
+	}
+	namespaced := *w
+	namespaced.Identifier = n.identifier(w.Identifier)
+	namespaced.Title = n.titlePointer(w.Title)
+	if w.Mappings != nil {
+		namespaced.Mappings = lo.Map(w.Mappings, func(mapping Mappings, _ int) Mappings {
+			mapping.Blueprint = n.identifier(mapping.Blueprint)
+			return mapping
+		})
+	}
+	return &namespaced
+}
+
+func (n Namespace) webhookFromPort(w *Webhook) {
+	if n.isEmpty() || w == nil {
+		return
+	}
+	w.Identifier = n.stripIdentifier(w.Identifier)
+	w.Title = n.stripTitlePointer(w.Title)
+	for i := range w.Mappings {
+		w.Mappings[i].Blueprint = n.stripIdentifier(w.Mappings[i].Blueprint)
+	}
+}
+
+// pageToPort returns a copy of the page with the prefixes, the page of the caller isn't changed
+func (n Namespace) pageToPort(p *Page) *Page {
+	if n.isEmpty() || p == nil {
+		return p
+	}
+	namespaced := *p
+	namespaced.Identifier = n.identifier(p.Identifier)
+	namespaced.Title = n.titlePointer(p.Title)
+	namespaced.Blueprint = n.identifierPointer(p.Blueprint)
+	namespaced.Parent = n.identifierPointer(p.Parent)
+	namespaced.After = n.identifierPointer(p.After)
+	namespaced.Widgets = rewriteWidgetsBlueprints(p.Widgets, n.identifier)
+	return &namespaced
+}
+
+func (n Namespace) pageFromPort(p *Page) {
+	if n.isEmpty() || p == nil {
+		return
+	}
+	p.Identifier = n.stripIdentifier(p.Identifier)
+	p.Title = n.stripTitlePointer(p.Title)
+	p.Blueprint = n.stripIdentifierPointer(p.Blueprint)
+	p.Parent = n.stripIdentifierPointer(p.Parent)
+	p.After = n.stripIdentifierPointer(p.After)
+	p.Widgets = rewriteWidgetsBlueprints(p.Widgets, n.stripIdentifier)
+}
+
+// rewriteWidgetsBlueprints returns a copy of the widgets with the blueprint fields of the widgets, and of the widgets
+// nested in them, rewritten. The blueprint identifiers in other fields, e.g. in the values of the dataset rules, are
+// sent as they are.
+func rewriteWidgetsBlueprints(widgets *[]map[string]any, rewrite func(string) string) *[]map[string]any {
+	if widgets == nil {
+		return nil
+	}
+	rewritten := lo.Map(*widgets, func(widget map[string]any, _ int) map[string]any {
+		return rewriteWidgetBlueprints(widget, rewrite)
+	})
+	return &rewritten
+}
+
+func rewriteWidgetBlueprints(widget map[string]any, rewrite func(string) string) map[string]any {
+	rewritten := lo.Assign(widget)
+	if blueprint, ok := widget["blueprint"].(string); ok {
+		rewritten["blueprint"] = rewrite(blueprint)
+	}
+	if nested, ok := widget["widgets"].([]any); ok {
+		rewritten["widgets"] = lo.Map(nested, func(item any, _ int) any {
+			if w, isObject := item.(map[string]any); isObject {
+				return rewriteWidgetBlueprints(w, rewrite)
+			}
+			return item
+		})
+	}
+	return rewritten
+}
+
+// folderToPort returns a copy of the folder with the prefixes, the sidebars are Port's and aren't prefixed
+func (n Namespace) folderToPort(f *Folder) *Folder {
+	if n.isEmpty() || f == nil {
+		return f
+	}
+	namespaced := *f
+	namespaced.Identifier = n.identifier(f.Identifier)
+	namespaced.Title = n.titlePointer(f.Title)
+	namespaced.Parent = n.identifierPointer(f.Parent)
+	namespaced.After = n.identifierPointer(f.After)
+	return &namespaced
+}
+
+func (n Namespace) folderFromPort(f *Folder) {
+	if n.isEmpty() || f == nil {
+		return
+	}
+	f.Identifier = n.stripIdentifier(f.Identifier)
+	f.Title = n.stripTitlePointer(f.Title)
+	f.Parent = n.stripIdentifierPointer(f.Parent)
+	f.After = n.stripIdentifierPointer(f.After)
+}
+
+// entityToPort returns a copy of the entity in the prefixed blueprint, the entity identifiers aren't prefixed
+func (n Namespace) entityToPort(e *Entity) *Entity {
+	if n.isEmpty() || e == nil {
+		return e
+	}
+	namespaced := *e
+	namespaced.Blueprint = n.identifier(e.Blueprint)
+	return &namespaced
+}
+
+func (n Namespace) entityFromPort(e *Entity) {
+	if n.isEmpty() || e == nil {
+		return
+	}
+	e.Blueprint = n.stripIdentifier(e.Blueprint)
+}
@@ -0,0 +1,273 @@
+package cli_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/porttest"
+	"github.com/samber/lo"
+)
+
+func newClients(t *testing.T) (namespaced *cli.PortClient, plain *cli.PortClient) {
+	server := porttest.NewServer()
+	t.Cleanup(server.Close)
+	for _, opts := range [][]cli.Option{{cli.WithNamespace("dev-", "[dev] ")}, nil} {
+		portClient, err := cli.New(server.URL, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = portClient.Authenticate(context.Background(), server.ClientID, server.ClientSecret); err != nil {
+			t.Fatal(err)
+		}
+		if namespaced == nil {
+			namespaced = portClient
+		} else {
+			plain = portClient
+		}
+	}
+	return namespaced, plain
+}
+
+func TestNamespaceBlueprints(t *testing.T) {
+	namespaced, plain := newClients(t)
+	ctx := context.Background()
+
+	if _, err := namespaced.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "environment", Title: "Environment"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	service := &cli.Blueprint{
+		Identifier: "service",
+		Title:      "Service",
+		Relations: map[string]cli.Relation{
+			"environment": {Title: lo.ToPtr("Environment"), Target: lo.ToPtr("environment")},
+		},
+	}
+	created, err := namespaced.CreateBlueprint(ctx, service, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if service.Identifier != "service" || *service.Relations["environment"].Target != "environment" {
+		t.Error("expected the blueprint of the caller not to be changed")
+	}
+	if created.Identifier != "service" || created.Title != "Service" || *created.Relations["environment"].Target != "environment" {
+		t.Errorf("expected the created blueprint to be returned without the prefixes, got %+v", created)
+	}
+
+	inPort, _, err := plain.ReadBlueprint(ctx, "dev-service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inPort.Title != "[dev] Service" || *inPort.Relations["environment"].Target != "dev-environment" {
+		t.Errorf("expected the blueprint in Port to have the prefixes, got %+v", inPort)
+	}
+
+	read, _, err := namespaced.ReadBlueprint(ctx, "service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.Identifier != "service" || read.Title != "Service" || *read.Relations["environment"].Target != "environment" {
+		t.Errorf("expected the read blueprint not to have the prefixes, got %+v", read)
+	}
+
+	if err = namespaced.DeleteBlueprint(ctx, "service"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = plain.ReadBlueprint(ctx, "dev-service"); err == nil {
+		t.Error("expected the prefixed blueprint to be deleted")
+	}
+}
+
+func TestNamespaceBlueprintJSON(t *testing.T) {
+	namespaced, plain := newClients(t)
+	ctx := context.Background()
+
+	if _, err := namespaced.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "environment", Title: "Environment"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	service := map[string]any{
+		"identifier": "service",
+		"title":      "Service",
+		"relations":  map[string]any{"environment": map[string]any{"title": "Environment", "target": "environment"}},
+	}
+	created, err := namespaced.CreateBlueprintJSON(ctx, service, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if service["identifier"] != "service" || service["relations"].(map[string]any)["environment"].(map[string]any)["target"] != "environment" {
+		t.Error("expected the blueprint of the caller not to be changed")
+	}
+	if created["identifier"] != "service" || created["title"] != "Service" {
+		t.Errorf("expected the created blueprint to be returned without the prefixes, got %+v", created)
+	}
+
+	inPort, _, err := plain.ReadBlueprint(ctx, "dev-service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inPort.Title != "[dev] Service" || *inPort.Relations["environment"].Target != "dev-environment" {
+		t.Errorf("expected the blueprint in Port to have the prefixes, got %+v", inPort)
+	}
+
+	read, _, err := namespaced.ReadBlueprintJSON(ctx, "service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read["identifier"] != "service" || read["relations"].(map[string]any)["environment"].(map[string]any)["target"] != "environment" {
+		t.Errorf("expected the read blueprint not to have the prefixes, got %+v", read)
+	}
+}
+
+func TestNamespaceActionsAndEntities(t *testing.T) {
+	namespaced, plain := newClients(t)
+	ctx := context.Background()
+
+	if _, err := namespaced.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "service", Title: "Service"}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	action := &cli.Action{
+		Identifier: "deploy",
+		Title:      lo.ToPtr("Deploy"),
+		Trigger: &cli.Trigger{
+			Type:                "self-service",
+			BlueprintIdentifier: lo.ToPtr("service"),
+			Operation:           lo.ToPtr("DAY-2"),
+			UserInputs: &cli.ActionUserInputs{
+				Properties: map[string]cli.ActionProperty{
+					"owner": {Type: "string", Format: lo.ToPtr("entity"), Blueprint: lo.ToPtr("_user")},
+				},
+			},
+		},
+		InvocationMethod: &cli.InvocationMethod{Type: "WEBHOOK", Url: lo.ToPtr("https://example.com")},
+	}
+	if _, err := namespaced.CreateAction(ctx, action); err != nil {
+		t.Fatal(err)
+	}
+	inPort, _, err := plain.ReadAction(ctx, "dev-deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *inPort.Title != "[dev] Deploy" || *inPort.Trigger.BlueprintIdentifier != "dev-service" {
+		t.Errorf("expected the action in Port to have the prefixes, got %+v", inPort)
+	}
+	if *inPort.Trigger.UserInputs.Properties["owner"].Blueprint != "_user" {
+		t.Error("expected the system blueprints not to be prefixed")
+	}
+	read, _, err := namespaced.ReadAction(ctx, "deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.Identifier != "deploy" || *read.Title != "Deploy" || *read.Trigger.BlueprintIdentifier != "service" {
+		t.Errorf("expected the read action not to have the prefixes, got %+v", read)
+	}
+
+	entity, err := namespaced.CreateEntity(ctx, &cli.Entity{Identifier: "checkout", Title: "Checkout", Blueprint: "service"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entity.Identifier != "checkout" || entity.Blueprint != "service" {
+		t.Errorf("expected the entity identifier not to be prefixed and its blueprint to be stripped, got %+v", entity)
+	}
+	if _, _, err = plain.ReadEntity(ctx, "checkout", "dev-service"); err != nil {
+		t.Errorf("expected the entity to be created in the prefixed blueprint: %s", err)
+	}
+}
+
+func TestNamespacePagesAndFolders(t *testing.T) {
+	namespaced, plain := newClients(t)
+	ctx := context.Background()
+
+	if _, err := namespaced.CreateFolder(ctx, "catalog", &cli.Folder{Identifier: "engineering", Title: lo.ToPtr("Engineering")}); err != nil {
+		t.Fatal(err)
+	}
+	services, err := namespaced.CreateFolder(ctx, "catalog", &cli.Folder{Identifier: "services", Title: lo.ToPtr("Services"), Parent: lo.ToPtr("engineering")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if services.Identifier != "services" || *services.Parent != "engineering" {
+		t.Errorf("expected the created folder to be returned without the prefixes, got %+v", services)
+	}
+	inPort, _, err := plain.ReadFolder(ctx, "catalog", "dev-services")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *inPort.Title != "[dev] Services" || *inPort.Parent != "dev-engineering" {
+		t.Errorf("expected the folder in Port to have the prefixes, got %+v", inPort)
+	}
+	read, _, err := namespaced.ReadFolder(ctx, "catalog", "services")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.Identifier != "services" || *read.Parent != "engineering" {
+		t.Errorf("expected the read folder not to have the prefixes, got %+v", read)
+	}
+
+	widgets := []map[string]any{{
+		"type": "dashboard-widget",
+		"widgets": []any{
+			map[string]any{"type": "table-entities-explorer", "blueprint": "service"},
+			map[string]any{"type": "table-entities-explorer", "blueprint": "_user"},
+		},
+	}}
+	page := &cli.Page{Identifier: "overview", Type: "dashboard", Title: lo.ToPtr("Overview"), Parent: lo.ToPtr("services"), Widgets: &widgets}
+	if _, err = namespaced.CreatePage(ctx, page); err != nil {
+		t.Fatal(err)
+	}
+	if widgets[0]["widgets"].([]any)[0].(map[string]any)["blueprint"] != "service" {
+		t.Error("expected the widgets of the caller not to be changed")
+	}
+	pageInPort, _, err := plain.GetPage(ctx, "dev-overview")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nested := (*pageInPort.Widgets)[0]["widgets"].([]any)
+	if *pageInPort.Parent != "dev-services" || nested[0].(map[string]any)["blueprint"] != "dev-service" || nested[1].(map[string]any)["blueprint"] != "_user" {
+		t.Errorf("expected the page in Port to have the prefixes, got %+v", pageInPort)
+	}
+	readPage, _, err := namespaced.GetPage(ctx, "overview")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *readPage.Parent != "services" || (*readPage.Widgets)[0]["widgets"].([]any)[0].(map[string]any)["blueprint"] != "service" {
+		t.Errorf("expected the read page not to have the prefixes, got %+v", readPage)
+	}
+}
+
+func TestNamespaceLists(t *testing.T) {
+	namespaced, plain := newClients(t)
+	ctx := context.Background()
+
+	for _, identifier := range []string{"service", "prod-service"} {
+		if _, err := plain.CreateBlueprint(ctx, &cli.Blueprint{Identifier: identifier, Title: "Service"}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := namespaced.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "service", Title: "Service"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := namespaced.CreateScorecard(ctx, "service", &cli.Scorecard{Identifier: "readiness", Title: "Readiness"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := plain.CreateScorecard(ctx, "prod-service", &cli.Scorecard{Identifier: "readiness", Title: "Readiness"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the objects of the other environments, and the objects without a prefix, aren't listed
+	blueprints, _, err := namespaced.ReadBlueprints(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	identifiers := lo.Filter(lo.Map(blueprints, func(b cli.Blueprint, _ int) string { return b.Identifier }), func(id string, _ int) bool { return !strings.HasPrefix(id, "_") })
+	if len(identifiers) != 1 || identifiers[0] != "service" {
+		t.Errorf("expected only the blueprint service of the namespace, got %v", identifiers)
+	}
+	scorecards, _, err := namespaced.ReadScorecards(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scorecards) != 1 || scorecards[0].Identifier != "readiness" || scorecards[0].Blueprint != "service" {
+		t.Errorf("expected only the scorecard of the namespace, got %+v", scorecards)
+	}
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+func (c *PortClient) ReadOrganization(ctx context.Context) (*Organization, int, error) {
+	pb := &PortBody{}
+	url := "v1/organization"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		Get(url)
+	if err != nil {
+		return nil, resp.StatusCode(), err
+	}
+	if !pb.OK {
+		return nil, resp.StatusCode(), fmt.Errorf("failed to read organization, got: %s", resp.Body())
+	}
+	return &pb.Organization, resp.StatusCode(), nil
+}
+
+// UpdateOrganizationSettings patches the organization settings, settings that are omitted from the body are left as is
+func (c *PortClient) UpdateOrganizationSettings(ctx context.Context, settings *OrganizationSettings) (*Organization, error) {
+	url := "v1/organization"
+	resp, err := c.Client.R().
+		SetBody(map[string]any{"settings": settings}).
+		SetContext(ctx).
+		Patch(url)
+	if err != nil {
+		return nil, err
+	}
+	var pb PortBody
+	err = json.Unmarshal(resp.Body(), &pb)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to update organization settings, got: %s", resp.Body())
+	}
+	return &pb.Organization, nil
+}
+
+// ReadOrganizationSecrets lists the secrets of the organization, only their names and descriptions are returned
+func (c *PortClient) ReadOrganizationSecrets(ctx context.Context) ([]OrganizationSecret, int, error) {
+	pb := &PortBody{}
+	url := "v1/organization/secrets"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		Get(url)
+	if err != nil {
+		return nil, resp.StatusCode(), err
+	}
+	if !pb.OK {
+		return nil, resp.StatusCode(), fmt.Errorf("failed to read organization secrets, got: %s", resp.Body())
+	}
+	return pb.Secrets, resp.StatusCode(), nil
+}
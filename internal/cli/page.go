@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+
+	"github.com/samber/lo"
 )
 
 func (c *PortClient) GetPage(ctx context.Context, pageId string) (*Page, int, error) {
@@ -13,7 +15,7 @@ func (c *PortClient) GetPage(ctx context.Context, pageId string) (*Page, int, er
 		SetContext(ctx).
 		SetHeader("Accept", "application/json").
 		SetResult(pb).
-		SetPathParam("page_identifier", pageId).
+		SetPathParam("page_identifier", c.Namespace.identifier(pageId)).
 		Get(url)
 	if err != nil {
 		return nil, resp.StatusCode(), err
@@ -21,14 +23,38 @@ func (c *PortClient) GetPage(ctx context.Context, pageId string) (*Page, int, er
 	if !pb.OK {
 		return nil, resp.StatusCode(), fmt.Errorf("failed to get page, got: %s", resp.Body())
 	}
+	c.Namespace.pageFromPort(&pb.Page)
 	return &pb.Page, resp.StatusCode(), nil
 
 }
 
+func (c *PortClient) GetPages(ctx context.Context) ([]Page, int, error) {
+	pb := &PortBody{}
+	url := "v1/pages"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		Get(url)
+	if err != nil {
+		return nil, resp.StatusCode(), err
+	}
+	if !pb.OK {
+		return nil, resp.StatusCode(), fmt.Errorf("failed to get pages, got: %s", resp.Body())
+	}
+	pb.Pages = lo.Filter(pb.Pages, func(p Page, _ int) bool {
+		return c.Namespace.inNamespace(p.Identifier)
+	})
+	for i := range pb.Pages {
+		c.Namespace.pageFromPort(&pb.Pages[i])
+	}
+	return pb.Pages, resp.StatusCode(), nil
+}
+
 func (c *PortClient) CreatePage(ctx context.Context, page *Page) (*Page, error) {
 	url := "v1/pages"
 	resp, err := c.Client.R().
-		SetBody(page).
+		SetBody(c.Namespace.pageToPort(page)).
 		SetContext(ctx).
 		Post(url)
 	if err != nil {
@@ -49,6 +75,7 @@ func (c *PortClient) CreatePage(ctx context.Context, page *Page) (*Page, error)
 	// The current API response body is { "ok": true, "identifier": "page_identifier" },
 	// but it is expected to be the page object in the future to align with other API endpoints.
 	if pb.Page.Identifier != "" {
+		c.Namespace.pageFromPort(&pb.Page)
 		return &pb.Page, nil
 	}
 	return nil, nil
@@ -57,9 +84,9 @@ func (c *PortClient) CreatePage(ctx context.Context, page *Page) (*Page, error)
 func (c *PortClient) UpdatePage(ctx context.Context, pageId string, page *Page) (*Page, error) {
 	url := "v1/pages/{page_identifier}"
 	resp, err := c.Client.R().
-		SetBody(page).
+		SetBody(c.Namespace.pageToPort(page)).
 		SetContext(ctx).
-		SetPathParam("page_identifier", pageId).
+		SetPathParam("page_identifier", c.Namespace.identifier(pageId)).
 		Put(url)
 	if err != nil {
 		return nil, err
@@ -72,6 +99,7 @@ func (c *PortClient) UpdatePage(ctx context.Context, pageId string, page *Page)
 	if !pb.OK {
 		return nil, fmt.Errorf("failed to update page, got: %s", resp.Body())
 	}
+	c.Namespace.pageFromPort(&pb.Page)
 	return &pb.Page, nil
 }
 
@@ -79,7 +107,7 @@ func (c *PortClient) DeletePage(ctx context.Context, pageId string) (int, error)
 	url := "v1/pages/{page_identifier}"
 	resp, err := c.Client.R().
 		SetContext(ctx).
-		SetPathParam("page_identifier", pageId).
+		SetPathParam("page_identifier", c.Namespace.identifier(pageId)).
 		Delete(url)
 	if err != nil {
 		return resp.StatusCode(), err
@@ -13,7 +13,7 @@ func (c *PortClient) GetPagePermissions(ctx context.Context, pageID string) (*Pa
 		SetContext(ctx).
 		SetHeader("Accept", "application/json").
 		SetResult(pppb).
-		SetPathParam("page_identifier", pageID).
+		SetPathParam("page_identifier", c.Namespace.identifier(pageID)).
 		Get(url)
 	if err != nil {
 		return nil, resp.StatusCode(), err
@@ -31,7 +31,7 @@ func (c *PortClient) UpdatePagePermissions(ctx context.Context, pageID string, p
 	resp, err := c.Client.R().
 		SetBody(permissions).
 		SetContext(ctx).
-		SetPathParam("page_identifier", pageID).
+		SetPathParam("page_identifier", c.Namespace.identifier(pageID)).
 		Patch(url)
 	if err != nil {
 		return nil, err
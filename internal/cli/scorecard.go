@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+
+	"github.com/samber/lo"
 )
 
 func (c *PortClient) ReadScorecard(ctx context.Context, blueprintID string, scorecardID string) (*Scorecard, int, error) {
@@ -13,8 +15,8 @@ func (c *PortClient) ReadScorecard(ctx context.Context, blueprintID string, scor
 		SetContext(ctx).
 		SetHeader("Accept", "application/json").
 		SetResult(pb).
-		SetPathParam("blueprint_identifier", blueprintID).
-		SetPathParam("scorecard_identifier", scorecardID).
+		SetPathParam("blueprint_identifier", c.Namespace.identifier(blueprintID)).
+		SetPathParam("scorecard_identifier", c.Namespace.identifier(scorecardID)).
 		Get(url)
 	if err != nil {
 		return nil, resp.StatusCode(), err
@@ -22,15 +24,39 @@ func (c *PortClient) ReadScorecard(ctx context.Context, blueprintID string, scor
 	if !pb.OK {
 		return nil, resp.StatusCode(), fmt.Errorf("failed to read scorecard, got: %s", resp.Body())
 	}
+	c.Namespace.scorecardFromPort(&pb.Scorecard)
 	return &pb.Scorecard, resp.StatusCode(), nil
 }
 
+func (c *PortClient) ReadScorecards(ctx context.Context) ([]Scorecard, int, error) {
+	pb := &PortBody{}
+	url := "v1/scorecards"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		Get(url)
+	if err != nil {
+		return nil, resp.StatusCode(), err
+	}
+	if !pb.OK {
+		return nil, resp.StatusCode(), fmt.Errorf("failed to read scorecards, got: %s", resp.Body())
+	}
+	pb.Scorecards = lo.Filter(pb.Scorecards, func(s Scorecard, _ int) bool {
+		return c.Namespace.inNamespace(s.Identifier)
+	})
+	for i := range pb.Scorecards {
+		c.Namespace.scorecardFromPort(&pb.Scorecards[i])
+	}
+	return pb.Scorecards, resp.StatusCode(), nil
+}
+
 func (c *PortClient) CreateScorecard(ctx context.Context, blueprintID string, scorecard *Scorecard) (*Scorecard, error) {
 	url := "v1/blueprints/{blueprint_identifier}/scorecards"
 	resp, err := c.Client.R().
-		SetBody(scorecard).
+		SetBody(c.Namespace.scorecardToPort(scorecard)).
 		SetContext(ctx).
-		SetPathParam("blueprint_identifier", blueprintID).
+		SetPathParam("blueprint_identifier", c.Namespace.identifier(blueprintID)).
 		Post(url)
 	if err != nil {
 		return nil, err
@@ -43,16 +69,17 @@ func (c *PortClient) CreateScorecard(ctx context.Context, blueprintID string, sc
 	if !pb.OK {
 		return nil, fmt.Errorf("failed to create scorecard, got: %s", resp.Body())
 	}
+	c.Namespace.scorecardFromPort(&pb.Scorecard)
 	return &pb.Scorecard, nil
 }
 
 func (c *PortClient) UpdateScorecard(ctx context.Context, blueprintID string, scorecardId string, scorecard *Scorecard) (*Scorecard, error) {
 	url := "v1/blueprints/{blueprint_identifier}/scorecards/{scorecard_identifier}"
 	resp, err := c.Client.R().
-		SetBody(scorecard).
+		SetBody(c.Namespace.scorecardToPort(scorecard)).
 		SetContext(ctx).
-		SetPathParam("blueprint_identifier", blueprintID).
-		SetPathParam("scorecard_identifier", scorecardId).
+		SetPathParam("blueprint_identifier", c.Namespace.identifier(blueprintID)).
+		SetPathParam("scorecard_identifier", c.Namespace.identifier(scorecardId)).
 		Put(url)
 	if err != nil {
 		return nil, err
@@ -65,6 +92,7 @@ func (c *PortClient) UpdateScorecard(ctx context.Context, blueprintID string, sc
 	if !pb.OK {
 		return nil, fmt.Errorf("failed to update scorecard, got: %s", resp.Body())
 	}
+	c.Namespace.scorecardFromPort(&pb.Scorecard)
 	return &pb.Scorecard, nil
 }
 
@@ -73,8 +101,8 @@ func (c *PortClient) DeleteScorecard(ctx context.Context, blueprintID string, sc
 	resp, err := c.Client.R().
 		SetContext(ctx).
 		SetHeader("Accept", "application/json").
-		SetPathParam("blueprint_identifier", blueprintID).
-		SetPathParam("scorecard_identifier", scorecardID).
+		SetPathParam("blueprint_identifier", c.Namespace.identifier(blueprintID)).
+		SetPathParam("scorecard_identifier", c.Namespace.identifier(scorecardID)).
 		Delete(url)
 	if err != nil {
 		return err
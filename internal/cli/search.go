@@ -46,3 +46,47 @@ func (c *PortClient) Search(ctx context.Context, searchRequest *SearchRequestQue
 	}
 	return &searchResult, nil
 }
+
+// searchPageSize is the number of entities in a page of the blueprint entities search, the most Port returns
+const searchPageSize = 1000
+
+// SearchBlueprintEntities returns all the entities of the blueprint, following the pages of the search. When include
+// is set, the entities only have the fields it lists.
+func (c *PortClient) SearchBlueprintEntities(ctx context.Context, blueprint string, include []string) ([]Entity, error) {
+	url := "v1/blueprints/{blueprint}/entities/search"
+	var entities []Entity
+	var from *string
+	for {
+		body := map[string]any{"limit": searchPageSize}
+		if len(include) > 0 {
+			body["include"] = include
+		}
+		if from != nil {
+			body["from"] = *from
+		}
+		resp, err := c.Client.R().
+			SetContext(ctx).
+			SetHeader("Accept", "application/json").
+			SetBody(body).
+			SetPathParam("blueprint", c.Namespace.identifier(blueprint)).
+			Post(url)
+		if err != nil {
+			return nil, err
+		}
+		var result BlueprintEntitiesSearchResult
+		if err = json.Unmarshal(resp.Body(), &result); err != nil {
+			return nil, err
+		}
+		if !result.OK {
+			return nil, fmt.Errorf("failed to search the entities of blueprint %s, got: %s", blueprint, resp.Body())
+		}
+		for i := range result.Entities {
+			c.Namespace.entityFromPort(&result.Entities[i])
+		}
+		entities = append(entities, result.Entities...)
+		if result.Next == nil || *result.Next == "" {
+			return entities, nil
+		}
+		from = result.Next
+	}
+}
@@ -0,0 +1,45 @@
+package cli_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+func TestSearchBlueprintEntitiesFollowsPages(t *testing.T) {
+	namespaced, _ := newClients(t)
+	ctx := context.Background()
+
+	if _, err := namespaced.CreateBlueprint(ctx, &cli.Blueprint{
+		Identifier: "service",
+		Title:      "Service",
+		Schema:     cli.BlueprintSchema{Properties: map[string]cli.BlueprintProperty{"language": {Type: "string"}}},
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+	// more entities than a single page of the search holds
+	const count = 1001
+	for i := 0; i < count; i++ {
+		e := &cli.Entity{Identifier: fmt.Sprintf("service-%04d", i), Title: "Service", Blueprint: "service", Properties: map[string]any{"language": "go"}}
+		if _, err := namespaced.CreateEntity(ctx, e, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entities, err := namespaced.SearchBlueprintEntities(ctx, "service", []string{"identifier", "properties"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entities) != count {
+		t.Fatalf("expected %d entities, got %d", count, len(entities))
+	}
+	last := entities[count-1]
+	if last.Identifier != "service-1000" || last.Properties["language"] != "go" {
+		t.Errorf("expected the last entity with its properties, got %+v", last)
+	}
+	if last.Title != "" {
+		t.Errorf("expected the entities to only have the included fields, got %+v", last)
+	}
+}
@@ -43,6 +43,24 @@ func (c *PortClient) ReadTeam(ctx context.Context, teamName string) (*Team, int,
 	return team, resp.StatusCode(), nil
 }
 
+func (c *PortClient) ReadTeams(ctx context.Context) ([]Team, int, error) {
+	pb := &PortBody{}
+	url := "v1/teams"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetQueryParamsFromValues(map[string][]string{"fields": {"name", "provider"}}).
+		SetResult(pb).
+		Get(url)
+	if err != nil {
+		return nil, resp.StatusCode(), err
+	}
+	if !pb.OK {
+		return nil, resp.StatusCode(), fmt.Errorf("failed to read teams, got: %s", resp.Body())
+	}
+	return pb.Teams, resp.StatusCode(), nil
+}
+
 func (c *PortClient) CreateTeam(ctx context.Context, team *Team) (*Team, error) {
 	url := "v1/teams"
 	resp, err := c.Client.R().
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+
+	"github.com/samber/lo"
 )
 
 func (c *PortClient) ReadWebhook(ctx context.Context, webhookID string) (*Webhook, int, error) {
@@ -13,7 +15,7 @@ func (c *PortClient) ReadWebhook(ctx context.Context, webhookID string) (*Webhoo
 		SetContext(ctx).
 		SetHeader("Accept", "application/json").
 		SetResult(pb).
-		SetPathParam("webhook_identifier", webhookID).
+		SetPathParam("webhook_identifier", c.Namespace.identifier(webhookID)).
 		Get(url)
 	if err != nil {
 		return nil, resp.StatusCode(), err
@@ -21,13 +23,37 @@ func (c *PortClient) ReadWebhook(ctx context.Context, webhookID string) (*Webhoo
 	if !pb.OK {
 		return nil, resp.StatusCode(), fmt.Errorf("failed to read webhook, got: %s", resp.Body())
 	}
+	c.Namespace.webhookFromPort(&pb.Webhook)
 	return &pb.Webhook, resp.StatusCode(), nil
 }
 
+func (c *PortClient) ReadWebhooks(ctx context.Context) ([]Webhook, int, error) {
+	pb := &PortBody{}
+	url := "v1/webhooks"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		Get(url)
+	if err != nil {
+		return nil, resp.StatusCode(), err
+	}
+	if !pb.OK {
+		return nil, resp.StatusCode(), fmt.Errorf("failed to read webhooks, got: %s", resp.Body())
+	}
+	pb.Webhooks = lo.Filter(pb.Webhooks, func(w Webhook, _ int) bool {
+		return c.Namespace.inNamespace(w.Identifier)
+	})
+	for i := range pb.Webhooks {
+		c.Namespace.webhookFromPort(&pb.Webhooks[i])
+	}
+	return pb.Webhooks, resp.StatusCode(), nil
+}
+
 func (c *PortClient) CreateWebhook(ctx context.Context, webhook *Webhook) (*Webhook, error) {
 	url := "v1/webhooks"
 	resp, err := c.Client.R().
-		SetBody(webhook).
+		SetBody(c.Namespace.webhookToPort(webhook)).
 		SetContext(ctx).
 		Post(url)
 	if err != nil {
@@ -42,15 +68,16 @@ func (c *PortClient) CreateWebhook(ctx context.Context, webhook *Webhook) (*Webh
 		return nil, fmt.Errorf("failed to create webhook, got: %s", resp.Body())
 	}
 
+	c.Namespace.webhookFromPort(&pb.Webhook)
 	return &pb.Webhook, nil
 }
 
 func (c *PortClient) UpdateWebhook(ctx context.Context, webhookID string, webhook *Webhook) (*Webhook, error) {
 	url := "v1/webhooks/{webhook_identifier}"
 	resp, err := c.Client.R().
-		SetBody(webhook).
+		SetBody(c.Namespace.webhookToPort(webhook)).
 		SetContext(ctx).
-		SetPathParam("webhook_identifier", webhookID).
+		SetPathParam("webhook_identifier", c.Namespace.identifier(webhookID)).
 		Put(url)
 	if err != nil {
 		return nil, err
@@ -64,6 +91,7 @@ func (c *PortClient) UpdateWebhook(ctx context.Context, webhookID string, webhoo
 		return nil, fmt.Errorf("failed to create webhook, got: %s", resp.Body())
 	}
 
+	c.Namespace.webhookFromPort(&pb.Webhook)
 	return &pb.Webhook, nil
 }
 
@@ -72,7 +100,7 @@ func (c *PortClient) DeleteWebhook(ctx context.Context, webhookID string) error
 	resp, err := c.Client.R().
 		SetContext(ctx).
 		SetHeader("Accept", "application/json").
-		SetPathParam("webhook_identifier", webhookID).
+		SetPathParam("webhook_identifier", c.Namespace.identifier(webhookID)).
 		Delete(url)
 	if err != nil {
 		return err
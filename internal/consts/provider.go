@@ -2,6 +2,7 @@ package consts
 
 const (
 	ProviderName         = "port"
+	ProviderAddress      = "registry.terraform.io/port-labs/port-labs"
 	DefaultBaseUrl       = "https://api.getport.io"
 	Kafka                = "KAFKA"
 	Webhook              = "WEBHOOK"
@@ -0,0 +1,158 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var invalidResourceNameChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// importTarget is a single Port object that is exported as a resource block and an import block
+type importTarget struct {
+	// name is the part of the resource address that is derived from the Port identifiers
+	name string
+	id   string
+}
+
+// exportedResource is a resource type of the provider that can be exported and the way to list its Port objects
+type exportedResource struct {
+	newResource func() resource.Resource
+	list        func(ctx context.Context, portClient *cli.PortClient) ([]importTarget, error)
+}
+
+// Export reads the Port objects of the organization of portClient and writes them to w as resource blocks along with
+// the import blocks that adopt them into the Terraform state
+func Export(ctx context.Context, portClient *cli.PortClient, w io.Writer) error {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	for _, exported := range exportedResources {
+		r := exported.newResource()
+		metadataResp := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: consts.ProviderName}, metadataResp)
+		typeName := metadataResp.TypeName
+
+		targets, err := exported.list(ctx, portClient)
+		if err != nil {
+			return fmt.Errorf("failed to list %s: %w", typeName, err)
+		}
+		sort.Slice(targets, func(i, j int) bool {
+			return targets[i].name < targets[j].name
+		})
+
+		names := make(map[string]bool)
+		for _, target := range targets {
+			name := resourceName(target.name, names)
+			state, err := readResource(ctx, portClient, r, target.id)
+			if err != nil {
+				return fmt.Errorf("failed to read %s %s: %w", typeName, target.id, err)
+			}
+			// the object was deleted since it was listed
+			if state == nil {
+				continue
+			}
+
+			if len(body.Blocks()) > 0 {
+				body.AppendNewline()
+			}
+			resourceBody := body.AppendNewBlock("resource", []string{typeName, name}).Body()
+			if err = writeAttributes(ctx, resourceBody, state); err != nil {
+				return fmt.Errorf("failed to write %s %s: %w", typeName, target.id, err)
+			}
+
+			body.AppendNewline()
+			importBody := body.AppendNewBlock("import", nil).Body()
+			importBody.SetAttributeTraversal("to", hcl.Traversal{
+				hcl.TraverseRoot{Name: typeName},
+				hcl.TraverseAttr{Name: name},
+			})
+			importBody.SetAttributeValue("id", cty.StringVal(target.id))
+		}
+	}
+
+	_, err := w.Write(hclwrite.Format(f.Bytes()))
+	return err
+}
+
+// readResource reads a Port object the same way terraform import does, the resource's ImportState sets the
+// attributes of the import ID and its Read fills the rest of the state
+func readResource(ctx context.Context, portClient *cli.PortClient, r resource.Resource, id string) (*tfsdk.State, error) {
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return nil, diagnosticsError(schemaResp.Diagnostics)
+	}
+
+	if rc, ok := r.(resource.ResourceWithConfigure); ok {
+		configureResp := &resource.ConfigureResponse{}
+		rc.Configure(ctx, resource.ConfigureRequest{ProviderData: portClient}, configureResp)
+		if configureResp.Diagnostics.HasError() {
+			return nil, diagnosticsError(configureResp.Diagnostics)
+		}
+	}
+
+	ri, ok := r.(resource.ResourceWithImportState)
+	if !ok {
+		return nil, fmt.Errorf("resource doesn't support import")
+	}
+	importResp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	ri.ImportState(ctx, resource.ImportStateRequest{ID: id}, importResp)
+	if importResp.Diagnostics.HasError() {
+		return nil, diagnosticsError(importResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		return nil, diagnosticsError(readResp.Diagnostics)
+	}
+	if readResp.State.Raw.IsNull() {
+		return nil, nil
+	}
+	return &readResp.State, nil
+}
+
+// resourceName turns Port identifiers into a valid and unique Terraform resource name
+func resourceName(name string, names map[string]bool) string {
+	name = invalidResourceNameChars.ReplaceAllString(name, "_")
+	if name == "" || !isIdentifierStart(name[0]) {
+		name = "_" + name
+	}
+	unique := name
+	for i := 2; names[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	names[unique] = true
+	return unique
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func diagnosticsError(diags diag.Diagnostics) error {
+	messages := make([]string, 0, diags.ErrorsCount())
+	for _, d := range diags.Errors() {
+		messages = append(messages, fmt.Sprintf("%s: %s", d.Summary(), d.Detail()))
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "; "))
+}
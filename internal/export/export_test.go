@@ -0,0 +1,71 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/export"
+)
+
+var update = flag.Bool("update", false, "update the expected export in testdata")
+
+// newFakeAPI serves the responses of the Port API from testdata/api, the file of a request is its path with a .json
+// extension
+func newFakeAPI(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s request to %s, the export should only read from the API", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := os.ReadFile(filepath.Join("testdata", "api", filepath.FromSlash(r.URL.Path)+".json"))
+		w.Header().Set("Content-Type", "application/json")
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"ok": false, "error": "not_found"}`))
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestExport(t *testing.T) {
+	server := newFakeAPI(t)
+	portClient, err := cli.New(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err = export.Export(context.Background(), portClient, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, diags := hclparse.NewParser().ParseHCL(out.Bytes(), "export.tf"); diags.HasErrors() {
+		t.Fatalf("the export isn't valid HCL: %s", diags.Error())
+	}
+
+	expectedPath := filepath.Join("testdata", "export.tf")
+	if *update {
+		if err = os.WriteFile(expectedPath, out.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected, err := os.ReadFile(expectedPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(expected, out.Bytes()) {
+		t.Errorf("unexpected export, run the test with -update to accept it\nexpected:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
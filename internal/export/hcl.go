@@ -0,0 +1,249 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// writeAttributes writes the configurable attributes of the state to the body of a resource block, attributes that
+// are null, computed only or equal to their default are left out like they would be in a hand-written configuration
+func writeAttributes(ctx context.Context, body *hclwrite.Body, state *tfsdk.State) error {
+	s, ok := state.Schema.(schema.Schema)
+	if !ok {
+		return fmt.Errorf("unexpected schema type %T", state.Schema)
+	}
+	attributes := make(map[string]tftypes.Value)
+	if err := state.Raw.As(&attributes); err != nil {
+		return err
+	}
+	for _, name := range sortedKeys(attributes) {
+		p := tftypes.NewAttributePath().WithAttributeName(name)
+		if omitAttribute(ctx, s, p, attributes[name]) {
+			continue
+		}
+		tokens, err := valueTokens(ctx, s, p, attributes[name])
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		body.SetAttributeRaw(name, tokens)
+	}
+	return nil
+}
+
+func omitAttribute(ctx context.Context, s schema.Schema, p *tftypes.AttributePath, value tftypes.Value) bool {
+	a, err := s.AttributeAtTerraformPath(ctx, p)
+	// the attributes of object values aren't schema attributes, all of them must be set
+	if err != nil {
+		return false
+	}
+	if value.IsNull() {
+		return true
+	}
+	if a.IsComputed() && !a.IsOptional() && !a.IsRequired() {
+		return true
+	}
+	return isDefaultValue(ctx, a, value)
+}
+
+func isDefaultValue(ctx context.Context, a interface{}, value tftypes.Value) bool {
+	switch a := a.(type) {
+	case schema.StringAttribute:
+		if a.Default == nil {
+			return false
+		}
+		resp := &defaults.StringResponse{}
+		a.Default.DefaultString(ctx, defaults.StringRequest{}, resp)
+		var v string
+		return value.As(&v) == nil && resp.PlanValue.ValueString() == v
+	case schema.BoolAttribute:
+		if a.Default == nil {
+			return false
+		}
+		resp := &defaults.BoolResponse{}
+		a.Default.DefaultBool(ctx, defaults.BoolRequest{}, resp)
+		var v bool
+		return value.As(&v) == nil && resp.PlanValue.ValueBool() == v
+	case schema.Int64Attribute:
+		if a.Default == nil {
+			return false
+		}
+		resp := &defaults.Int64Response{}
+		a.Default.DefaultInt64(ctx, defaults.Int64Request{}, resp)
+		v := new(big.Float)
+		return value.As(&v) == nil && v.Cmp(new(big.Float).SetInt64(resp.PlanValue.ValueInt64())) == 0
+	case schema.Float64Attribute:
+		if a.Default == nil {
+			return false
+		}
+		resp := &defaults.Float64Response{}
+		a.Default.DefaultFloat64(ctx, defaults.Float64Request{}, resp)
+		v := new(big.Float)
+		return value.As(&v) == nil && v.Cmp(big.NewFloat(resp.PlanValue.ValueFloat64())) == 0
+	}
+	return false
+}
+
+func valueTokens(ctx context.Context, s schema.Schema, p *tftypes.AttributePath, value tftypes.Value) (hclwrite.Tokens, error) {
+	if value.IsNull() {
+		return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType)), nil
+	}
+
+	switch {
+	case value.Type().Is(tftypes.Object{}):
+		attributes := make(map[string]tftypes.Value)
+		if err := value.As(&attributes); err != nil {
+			return nil, err
+		}
+		var objectAttributes []hclwrite.ObjectAttrTokens
+		for _, name := range sortedKeys(attributes) {
+			attributePath := p.WithAttributeName(name)
+			if omitAttribute(ctx, s, attributePath, attributes[name]) {
+				continue
+			}
+			tokens, err := valueTokens(ctx, s, attributePath, attributes[name])
+			if err != nil {
+				return nil, err
+			}
+			objectAttributes = append(objectAttributes, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForIdentifier(name),
+				Value: tokens,
+			})
+		}
+		return hclwrite.TokensForObject(objectAttributes), nil
+	case value.Type().Is(tftypes.Map{}):
+		elements := make(map[string]tftypes.Value)
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		var mapElements []hclwrite.ObjectAttrTokens
+		for _, key := range sortedKeys(elements) {
+			tokens, err := valueTokens(ctx, s, p.WithElementKeyString(key), elements[key])
+			if err != nil {
+				return nil, err
+			}
+			mapElements = append(mapElements, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+				Value: tokens,
+			})
+		}
+		return hclwrite.TokensForObject(mapElements), nil
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		var elementsTokens []hclwrite.Tokens
+		for i, element := range elements {
+			elementPath := p.WithElementKeyInt(i)
+			if value.Type().Is(tftypes.Set{}) {
+				elementPath = p.WithElementKeyValue(element)
+			}
+			tokens, err := valueTokens(ctx, s, elementPath, element)
+			if err != nil {
+				return nil, err
+			}
+			elementsTokens = append(elementsTokens, tokens)
+		}
+		return hclwrite.TokensForTuple(elementsTokens), nil
+	case value.Type().Is(tftypes.String):
+		var v string
+		if err := value.As(&v); err != nil {
+			return nil, err
+		}
+		if t, err := s.TypeAtTerraformPath(ctx, p); err == nil && t.Equal(jsontypes.NormalizedType{}) {
+			return jsonencodeTokens(v)
+		}
+		return hclwrite.TokensForValue(cty.StringVal(v)), nil
+	case value.Type().Is(tftypes.Number):
+		v := new(big.Float)
+		if err := value.As(&v); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.NumberVal(v)), nil
+	case value.Type().Is(tftypes.Bool):
+		var v bool
+		if err := value.As(&v); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.BoolVal(v)), nil
+	}
+	return nil, fmt.Errorf("unsupported value type %s", value.Type())
+}
+
+// jsonencodeTokens writes JSON strings as a jsonencode call of the equivalent HCL value, the JSON attributes compare
+// their values semantically so the plan stays empty
+func jsonencodeTokens(v string) (hclwrite.Tokens, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(v)))
+	decoder.UseNumber()
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		// the value isn't JSON, keep it as is so that the error is reported by terraform
+		return hclwrite.TokensForValue(cty.StringVal(v)), nil
+	}
+	ctyValue, err := jsonToCty(decoded)
+	if err != nil {
+		return nil, err
+	}
+	return hclwrite.TokensForFunctionCall("jsonencode", hclwrite.TokensForValue(ctyValue)), nil
+}
+
+func jsonToCty(v any) (cty.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	case bool:
+		return cty.BoolVal(v), nil
+	case string:
+		return cty.StringVal(v), nil
+	case json.Number:
+		return cty.ParseNumberVal(v.String())
+	case []any:
+		if len(v) == 0 {
+			return cty.EmptyTupleVal, nil
+		}
+		elements := make([]cty.Value, len(v))
+		for i, element := range v {
+			ctyElement, err := jsonToCty(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			elements[i] = ctyElement
+		}
+		return cty.TupleVal(elements), nil
+	case map[string]any:
+		if len(v) == 0 {
+			return cty.EmptyObjectVal, nil
+		}
+		attributes := make(map[string]cty.Value, len(v))
+		for key, element := range v {
+			ctyElement, err := jsonToCty(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			attributes[key] = ctyElement
+		}
+		return cty.ObjectVal(attributes), nil
+	}
+	return cty.NilVal, fmt.Errorf("unsupported JSON value %v", v)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
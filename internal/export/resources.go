@@ -0,0 +1,132 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/action"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/action-permissions"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint-permissions"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/page"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/page-permissions"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/scorecard"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/team"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/webhook"
+	"github.com/samber/lo"
+)
+
+// pageTypes are the page types that can be managed with the page resource, other pages are created by Port
+var pageTypes = []string{"blueprint-entities", "dashboard", "home"}
+
+// exportedResources are in the order they are written in the export
+var exportedResources = []exportedResource{
+	{newResource: blueprint.NewBlueprintResource, list: listBlueprints},
+	{newResource: blueprint_permissions.NewBlueprintPermissionsResource, list: listBlueprints},
+	{newResource: action.NewActionResource, list: listActions},
+	{newResource: action_permissions.NewActionPermissionsResource, list: listActions},
+	{newResource: scorecard.NewScorecardResource, list: listScorecards},
+	{newResource: webhook.NewWebhookResource, list: listWebhooks},
+	{newResource: page.NewPageResource, list: listPages},
+	{newResource: page_permissions.NewPagePermissionsResource, list: listPages},
+	{newResource: team.NewTeamResource, list: listTeams},
+}
+
+// isSystemIdentifier reports whether the identifier belongs to an object that Port creates and manages, like the
+// _user and _team blueprints
+func isSystemIdentifier(identifier string) bool {
+	return strings.HasPrefix(identifier, "_")
+}
+
+func listBlueprints(ctx context.Context, portClient *cli.PortClient) ([]importTarget, error) {
+	blueprints, _, err := portClient.ReadBlueprints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]importTarget, 0, len(blueprints))
+	for _, b := range blueprints {
+		if isSystemIdentifier(b.Identifier) {
+			continue
+		}
+		targets = append(targets, importTarget{name: b.Identifier, id: b.Identifier})
+	}
+	return targets, nil
+}
+
+func listActions(ctx context.Context, portClient *cli.PortClient) ([]importTarget, error) {
+	actions, _, err := portClient.ReadActions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]importTarget, 0, len(actions))
+	for _, a := range actions {
+		if isSystemIdentifier(a.Identifier) {
+			continue
+		}
+		targets = append(targets, importTarget{name: a.Identifier, id: a.Identifier})
+	}
+	return targets, nil
+}
+
+func listScorecards(ctx context.Context, portClient *cli.PortClient) ([]importTarget, error) {
+	scorecards, _, err := portClient.ReadScorecards(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]importTarget, 0, len(scorecards))
+	for _, s := range scorecards {
+		if isSystemIdentifier(s.Blueprint) {
+			continue
+		}
+		targets = append(targets, importTarget{
+			name: fmt.Sprintf("%s_%s", s.Blueprint, s.Identifier),
+			id:   fmt.Sprintf("%s:%s", s.Blueprint, s.Identifier),
+		})
+	}
+	return targets, nil
+}
+
+func listWebhooks(ctx context.Context, portClient *cli.PortClient) ([]importTarget, error) {
+	webhooks, _, err := portClient.ReadWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]importTarget, 0, len(webhooks))
+	for _, w := range webhooks {
+		targets = append(targets, importTarget{name: w.Identifier, id: w.Identifier})
+	}
+	return targets, nil
+}
+
+func listPages(ctx context.Context, portClient *cli.PortClient) ([]importTarget, error) {
+	pages, _, err := portClient.GetPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]importTarget, 0, len(pages))
+	for _, p := range pages {
+		if isSystemIdentifier(p.Identifier) || !lo.Contains(pageTypes, p.Type) {
+			continue
+		}
+		targets = append(targets, importTarget{name: p.Identifier, id: p.Identifier})
+	}
+	return targets, nil
+}
+
+func listTeams(ctx context.Context, portClient *cli.PortClient) ([]importTarget, error) {
+	teams, _, err := portClient.ReadTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]importTarget, 0, len(teams))
+	for _, t := range teams {
+		// teams that are synced from an identity provider can't be managed with Terraform
+		if t.Provider != "" && t.Provider != "port" {
+			continue
+		}
+		targets = append(targets, importTarget{name: t.Name, id: t.Name})
+	}
+	return targets, nil
+}
@@ -0,0 +1,246 @@
+resource "port_blueprint" "microservice" {
+  description = "A deployable service"
+  icon        = "Microservice"
+  identifier  = "microservice"
+  properties = {
+    number_props = {
+      "replicas" = {
+        default = 2
+        title   = "Replicas"
+      }
+    }
+    object_props = {
+      "config" = {
+        default = jsonencode({
+          retries = [1, 2]
+          timeout = 30
+        })
+        title = "Config"
+      }
+    }
+    string_props = {
+      "language" = {
+        enum     = ["Go", "Python"]
+        required = true
+        title    = "Language"
+      }
+    }
+  }
+  relations = {
+    "parent" = {
+      target = "microservice"
+      title  = "Parent"
+    }
+  }
+  title = "Microservice"
+}
+
+import {
+  to = port_blueprint.microservice
+  id = "microservice"
+}
+
+resource "port_blueprint_permissions" "microservice" {
+  blueprint_identifier = "microservice"
+  entities = {
+    register = {
+      roles = ["Admin"]
+      teams = []
+      users = []
+    }
+    unregister = {
+      roles = ["Admin"]
+      teams = []
+      users = []
+    }
+    update = {
+      owned_by_team = true
+      roles         = ["Admin", "Member"]
+      teams         = ["platform"]
+      users         = []
+    }
+    update_metadata_properties = {}
+    update_properties = {
+      "language" = {
+        roles = ["Admin"]
+        teams = []
+        users = []
+      }
+    }
+  }
+}
+
+import {
+  to = port_blueprint_permissions.microservice
+  id = "microservice"
+}
+
+resource "port_action" "deploy" {
+  icon              = "Deployment"
+  identifier        = "deploy"
+  required_approval = "false"
+  self_service_trigger = {
+    blueprint_identifier = "microservice"
+    operation            = "DAY-2"
+    order_properties     = ["environment"]
+    user_properties = {
+      string_props = {
+        "environment" = {
+          enum     = ["production", "staging"]
+          required = true
+          title    = "Environment"
+        }
+      }
+    }
+  }
+  title = "Deploy"
+  webhook_method = {
+    agent = "false"
+    body = jsonencode({
+      environment = "{{ .inputs.environment }}"
+      service     = "{{ .entity.identifier }}"
+    })
+    method       = "POST"
+    synchronized = "false"
+    url          = "https://example.com/deploy"
+  }
+}
+
+import {
+  to = port_action.deploy
+  id = "deploy"
+}
+
+resource "port_action_permissions" "deploy" {
+  action_identifier = "deploy"
+  permissions = {
+    approve = {
+      roles = []
+      teams = []
+      users = []
+    }
+    execute = {
+      roles = ["Admin"]
+      teams = []
+      users = []
+    }
+  }
+}
+
+import {
+  to = port_action_permissions.deploy
+  id = "deploy"
+}
+
+resource "port_scorecard" "microservice_ownership" {
+  blueprint  = "microservice"
+  identifier = "ownership"
+  levels = [{
+    color = "paleBlue"
+    title = "Basic"
+    }, {
+    color = "gold"
+    title = "Gold"
+  }]
+  rules = [{
+    identifier = "has_language"
+    level      = "Gold"
+    query = {
+      combinator = "and"
+      conditions = [jsonencode({
+        operator = "isNotEmpty"
+        property = "language"
+      })]
+    }
+    title = "Has language"
+  }]
+  title = "Ownership"
+}
+
+import {
+  to = port_scorecard.microservice_ownership
+  id = "microservice:ownership"
+}
+
+resource "port_webhook" "github" {
+  enabled    = true
+  icon       = "Github"
+  identifier = "github"
+  mappings = [{
+    blueprint = "microservice"
+    entity = {
+      identifier = ".body.repository.name"
+      properties = {
+        "language" = ".body.repository.language"
+      }
+      title = ".body.repository.full_name"
+    }
+    filter = ".headers.\"x-github-event\" == \"push\""
+  }]
+  security = {
+    secret                = "secret"
+    signature_algorithm   = "sha256"
+    signature_header_name = "X-Hub-Signature-256"
+    signature_prefix      = "sha256="
+  }
+  title = "GitHub"
+}
+
+import {
+  to = port_webhook.github
+  id = "github"
+}
+
+resource "port_page" "overview" {
+  icon       = "Apps"
+  identifier = "overview"
+  title      = "Overview"
+  type       = "dashboard"
+  widgets = [jsonencode({
+    id = "dashboardWidget"
+    layout = [{
+      columns = [{
+        id   = "readme"
+        size = 12
+      }]
+      height = 400
+    }]
+    type = "dashboard-widget"
+    widgets = [{
+      id       = "readme"
+      markdown = "# Hello"
+      title    = "Readme"
+      type     = "markdown"
+    }]
+  })]
+}
+
+import {
+  to = port_page.overview
+  id = "overview"
+}
+
+resource "port_page_permissions" "overview" {
+  page_identifier = "overview"
+  read = {
+    roles = ["Admin", "Member"]
+    teams = []
+    users = []
+  }
+}
+
+import {
+  to = port_page_permissions.overview
+  id = "overview"
+}
+
+resource "port_team" "platform" {
+  description = "The platform team"
+  name        = "platform"
+  users       = ["jane@example.com"]
+}
+
+import {
+  to = port_team.platform
+  id = "platform"
+}
@@ -0,0 +1,43 @@
+package importid
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Separator separates the identifiers of an import ID made of several identifiers, e.g. the import ID of an entity
+// is <blueprint>:<identifier>
+const Separator = ":"
+
+// Format returns the format of the import ID of a resource whose identifiers are imported into attributes
+func Format(attributes ...string) string {
+	parts := make([]string, len(attributes))
+	for i, attribute := range attributes {
+		parts[i] = fmt.Sprintf("<%s>", attribute)
+	}
+	return strings.Join(parts, Separator)
+}
+
+// Import sets the attributes of the resource to the identifiers of the import ID, in order. The import ID is the
+// identifier of the resource, preceded by the identifiers of the resources it belongs to, separated by colons, the
+// rest of the state is populated by the Read that follows the import. Identifiers may contain colons, so the last
+// attribute gets the rest of the import ID.
+func Import(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attributes ...string) {
+	identifiers := strings.SplitN(req.ID, Separator, len(attributes))
+	valid := len(identifiers) == len(attributes)
+	for _, identifier := range identifiers {
+		valid = valid && identifier != ""
+	}
+	if !valid {
+		resp.Diagnostics.AddError("invalid import ID", fmt.Sprintf("import ID must be in the format %s, got %q", Format(attributes...), req.ID))
+		return
+	}
+
+	for i, attribute := range attributes {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), identifiers[i])...)
+	}
+}
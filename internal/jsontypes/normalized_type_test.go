@@ -0,0 +1,72 @@
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+)
+
+func TestNormalizedTypeValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   tftypes.Value
+		isValid bool
+	}{
+		{
+			name:    "object",
+			value:   tftypes.NewValue(tftypes.String, `{"title":"Service","count":1.5e3}`),
+			isValid: true,
+		},
+		{
+			name:    "list with whitespace",
+			value:   tftypes.NewValue(tftypes.String, "[ 1,\n 2 ]"),
+			isValid: true,
+		},
+		{
+			name:    "string",
+			value:   tftypes.NewValue(tftypes.String, `"service"`),
+			isValid: true,
+		},
+		{
+			name:    "null",
+			value:   tftypes.NewValue(tftypes.String, nil),
+			isValid: true,
+		},
+		{
+			name:    "unknown",
+			value:   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			isValid: true,
+		},
+		{
+			name:    "truncated object",
+			value:   tftypes.NewValue(tftypes.String, `{"title":`),
+			isValid: false,
+		},
+		{
+			name:    "unquoted string",
+			value:   tftypes.NewValue(tftypes.String, `service`),
+			isValid: false,
+		},
+		{
+			name:    "trailing comma",
+			value:   tftypes.NewValue(tftypes.String, `{"title":"Service",}`),
+			isValid: false,
+		},
+		{
+			name:    "empty string",
+			value:   tftypes.NewValue(tftypes.String, ``),
+			isValid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := jsontypes.NormalizedType{}.Validate(context.Background(), tt.value, path.Root("body"))
+			if diags.HasError() == tt.isValid {
+				t.Errorf("expected valid: %t, got %v", tt.isValid, diags)
+			}
+		})
+	}
+}
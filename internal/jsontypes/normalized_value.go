@@ -0,0 +1,67 @@
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ basetypes.StringValuableWithSemanticEquals = Normalized{}
+
+// Normalized is the value of a NormalizedType attribute
+type Normalized struct {
+	basetypes.StringValue
+}
+
+func (v Normalized) Type(ctx context.Context) attr.Type {
+	return NormalizedType{}
+}
+
+func (v Normalized) Equal(o attr.Value) bool {
+	other, ok := o.(Normalized)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values decode to the same JSON, the prior value is kept when they do
+func (v Normalized) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Normalized)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("expected value type %T, got %T", v, newValuable))
+		return false, diags
+	}
+
+	var oldJson, newJson any
+	if err := json.Unmarshal([]byte(v.ValueString()), &oldJson); err != nil {
+		return false, diags
+	}
+	if err := json.Unmarshal([]byte(newValue.ValueString()), &newJson); err != nil {
+		return false, diags
+	}
+	return reflect.DeepEqual(oldJson, newJson), diags
+}
+
+func NewNormalizedNull() Normalized {
+	return Normalized{StringValue: basetypes.NewStringNull()}
+}
+
+func NewNormalizedUnknown() Normalized {
+	return Normalized{StringValue: basetypes.NewStringUnknown()}
+}
+
+func NewNormalizedValue(value string) Normalized {
+	return Normalized{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewNormalizedPointerValue(value *string) Normalized {
+	return Normalized{StringValue: basetypes.NewStringPointerValue(value)}
+}
@@ -0,0 +1,91 @@
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+)
+
+func TestNormalizedStringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		prior    string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "same JSON",
+			prior:    `{"title":"Service","count":1}`,
+			new:      `{"title":"Service","count":1}`,
+			expected: true,
+		},
+		{
+			name:     "different key order",
+			prior:    `{"title":"Service","schema":{"type":"string","format":"url"}}`,
+			new:      `{"schema":{"format":"url","type":"string"},"title":"Service"}`,
+			expected: true,
+		},
+		{
+			name:     "different whitespace",
+			prior:    `{"title":"Service","tags":["a","b"]}`,
+			new:      "{\n  \"title\": \"Service\",\n  \"tags\": [ \"a\", \"b\" ]\n}",
+			expected: true,
+		},
+		{
+			name:     "different number formatting",
+			prior:    `{"count":1,"ratio":0.5}`,
+			new:      `{"count":1.0,"ratio":5e-1}`,
+			expected: true,
+		},
+		{
+			name:     "different values",
+			prior:    `{"title":"Service"}`,
+			new:      `{"title":"Microservice"}`,
+			expected: false,
+		},
+		{
+			name:     "different list order",
+			prior:    `["a","b"]`,
+			new:      `["b","a"]`,
+			expected: false,
+		},
+		{
+			name:     "different types",
+			prior:    `{"count":1}`,
+			new:      `{"count":"1"}`,
+			expected: false,
+		},
+		{
+			name:     "invalid prior JSON",
+			prior:    `{"title":`,
+			new:      `{"title":"Service"}`,
+			expected: false,
+		},
+		{
+			name:     "invalid new JSON",
+			prior:    `{"title":"Service"}`,
+			new:      `not json`,
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, diags := jsontypes.NewNormalizedValue(tt.prior).StringSemanticEquals(context.Background(), jsontypes.NewNormalizedValue(tt.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != tt.expected {
+				t.Errorf("expected %s and %s to be semantically equal: %t, got %t", tt.prior, tt.new, tt.expected, equal)
+			}
+		})
+	}
+}
+
+func TestNormalizedStringSemanticEqualsOtherType(t *testing.T) {
+	_, diags := jsontypes.NewNormalizedValue(`{}`).StringSemanticEquals(context.Background(), types.StringValue(`{}`))
+	if !diags.HasError() {
+		t.Error("expected an error when comparing with a value of another type")
+	}
+}
@@ -0,0 +1,166 @@
+package porttest
+
+import "fmt"
+
+func (s *Server) listActions(r *request) response {
+	return ok("actions", sortedValues(s.actions))
+}
+
+func (s *Server) getAction(r *request) response {
+	a, found := s.actions[r.params["action"]]
+	if !found {
+		return notFound("Action", r.params["action"])
+	}
+	return ok("action", a)
+}
+
+func (s *Server) createAction(r *request) response {
+	a, err := s.validateAction(r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	identifier := a["identifier"].(string)
+	if _, found := s.actions[identifier]; found {
+		return conflict("Action", identifier)
+	}
+	a["id"] = s.generateID("action")
+	s.actions[identifier] = s.withMeta(a, nil)
+	s.actionPermissions[identifier] = object{
+		"execute": object{"users": []any{}, "roles": []any{"Admin"}, "teams": []any{}, "ownedByTeam": false, "policy": nil},
+		"approve": object{"users": []any{}, "roles": []any{}, "teams": []any{}, "policy": nil},
+	}
+	return created("action", a)
+}
+
+func (s *Server) updateAction(r *request) response {
+	identifier := r.params["action"]
+	existing, found := s.actions[identifier]
+	if !found {
+		return notFound("Action", identifier)
+	}
+	if r.body["identifier"] == nil {
+		r.body["identifier"] = identifier
+	}
+	a, err := s.validateAction(r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	newIdentifier := a["identifier"].(string)
+	if newIdentifier != identifier {
+		if _, found := s.actions[newIdentifier]; found {
+			return conflict("Action", newIdentifier)
+		}
+		s.actionPermissions[newIdentifier] = s.actionPermissions[identifier]
+		delete(s.actionPermissions, identifier)
+		delete(s.actions, identifier)
+	}
+	a["id"] = existing["id"]
+	s.actions[newIdentifier] = s.withMeta(a, existing)
+	return ok("action", a)
+}
+
+func (s *Server) deleteAction(r *request) response {
+	identifier := r.params["action"]
+	if _, found := s.actions[identifier]; !found {
+		return notFound("Action", identifier)
+	}
+	delete(s.actions, identifier)
+	delete(s.actionPermissions, identifier)
+	return deleted()
+}
+
+func (s *Server) getActionPermissions(r *request) response {
+	p, found := s.actionPermissions[r.params["action"]]
+	if !found {
+		return notFound("Action", r.params["action"])
+	}
+	return ok("permissions", p)
+}
+
+func (s *Server) updateActionPermissions(r *request) response {
+	p, found := s.actionPermissions[r.params["action"]]
+	if !found {
+		return notFound("Action", r.params["action"])
+	}
+	if err := validatePermissionsBlocks(r.body); err != nil {
+		return unprocessable("%s", err)
+	}
+	merge(p, r.body)
+	return ok("permissions", p)
+}
+
+func (s *Server) validateAction(body object) (object, error) {
+	a := clone(body).(object)
+	if err := validateIdentifier("identifier", a["identifier"]); err != nil {
+		return nil, err
+	}
+	for _, field := range []string{"title", "icon", "description"} {
+		if err := validateOptionalString(field, a[field]); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateOptionalBool("publish", a["publish"]); err != nil {
+		return nil, err
+	}
+
+	trigger, isObject := a["trigger"].(object)
+	if !isObject {
+		return nil, fmt.Errorf("trigger is required and must be an object")
+	}
+	switch trigger["type"] {
+	case "self-service":
+		if err := validateOneOf("trigger.operation", trigger["operation"], actionOperations); err != nil {
+			return nil, err
+		}
+		if _, err := optionalObject("trigger.userInputs", trigger["userInputs"]); err != nil {
+			return nil, err
+		}
+		if trigger["blueprintIdentifier"] != nil {
+			if err := s.validateBlueprintExists("trigger.blueprintIdentifier", trigger["blueprintIdentifier"]); err != nil {
+				return nil, err
+			}
+		}
+	case "automation":
+		event, isObject := trigger["event"].(object)
+		if !isObject {
+			return nil, fmt.Errorf("trigger.event is required for automations")
+		}
+		if err := validateRequiredString("trigger.event.type", event["type"]); err != nil {
+			return nil, err
+		}
+		if event["blueprintIdentifier"] != nil {
+			if err := s.validateBlueprintExists("trigger.event.blueprintIdentifier", event["blueprintIdentifier"]); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("trigger.type must be one of [self-service automation], got %v", trigger["type"])
+	}
+
+	invocationMethod, isObject := a["invocationMethod"].(object)
+	if !isObject {
+		return nil, fmt.Errorf("invocationMethod is required and must be an object")
+	}
+	if err := validateOneOf("invocationMethod.type", invocationMethod["type"], invocationMethodTypes); err != nil {
+		return nil, err
+	}
+	if invocationMethod["type"] == "WEBHOOK" {
+		if err := validateRequiredString("invocationMethod.url", invocationMethod["url"]); err != nil {
+			return nil, err
+		}
+	}
+	if invocationMethod["type"] == "UPSERT_ENTITY" {
+		if err := s.validateBlueprintExists("invocationMethod.blueprintIdentifier", invocationMethod["blueprintIdentifier"]); err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+func (s *Server) validateBlueprintExists(field string, value any) error {
+	identifier, _ := value.(string)
+	if _, found := s.blueprints[identifier]; !found {
+		return fmt.Errorf("%s references blueprint %v which doesn't exist", field, value)
+	}
+	return nil
+}
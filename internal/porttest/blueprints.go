@@ -0,0 +1,401 @@
+package porttest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (s *Server) listBlueprints(r *request) response {
+	return ok("blueprints", sortedValues(s.blueprints))
+}
+
+func (s *Server) getBlueprint(r *request) response {
+	b, found := s.blueprints[r.params["blueprint"]]
+	if !found {
+		return notFound("Blueprint", r.params["blueprint"])
+	}
+	return ok("blueprint", b)
+}
+
+func (s *Server) createBlueprint(r *request) response {
+	b, err := s.validateBlueprint(r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	identifier := b["identifier"].(string)
+	if _, found := s.blueprints[identifier]; found {
+		return conflict("Blueprint", identifier)
+	}
+
+	s.blueprints[identifier] = s.withMeta(b, nil)
+	s.entities[identifier] = make(map[string]object)
+	s.scorecards[identifier] = make(map[string]object)
+	s.blueprintPermissions[identifier] = defaultBlueprintPermissions(b)
+
+	// Port creates a catalog page for the entities of a new blueprint unless asked not to
+	if r.URL.Query().Get("create_catalog_page") != "false" {
+		pageIdentifier := catalogPageIdentifier(identifier)
+		if _, found := s.pages[pageIdentifier]; !found {
+			s.pages[pageIdentifier] = s.withMeta(object{
+				"identifier": pageIdentifier,
+				"type":       "blueprint-entities",
+				"blueprint":  identifier,
+				"title":      b["title"],
+				"icon":       b["icon"],
+				"widgets":    []any{},
+			}, nil)
+			s.pagePermissions[pageIdentifier] = defaultPagePermissions()
+		}
+	}
+	return created("blueprint", b)
+}
+
+func (s *Server) updateBlueprint(r *request) response {
+	identifier := r.params["blueprint"]
+	existing, found := s.blueprints[identifier]
+	if !found {
+		return notFound("Blueprint", identifier)
+	}
+	if r.body["identifier"] == nil {
+		r.body["identifier"] = identifier
+	}
+	b, err := s.validateBlueprint(r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	newIdentifier := b["identifier"].(string)
+	if newIdentifier != identifier {
+		if _, found := s.blueprints[newIdentifier]; found {
+			return conflict("Blueprint", newIdentifier)
+		}
+		s.renameBlueprint(identifier, newIdentifier)
+	}
+	s.blueprints[newIdentifier] = s.withMeta(b, existing)
+	return ok("blueprint", b)
+}
+
+// renameBlueprint moves everything that belongs to a blueprint to its new identifier
+func (s *Server) renameBlueprint(identifier string, newIdentifier string) {
+	delete(s.blueprints, identifier)
+	for _, e := range s.entities[identifier] {
+		e["blueprint"] = newIdentifier
+	}
+	s.entities[newIdentifier] = s.entities[identifier]
+	delete(s.entities, identifier)
+	for _, sc := range s.scorecards[identifier] {
+		sc["blueprint"] = newIdentifier
+	}
+	s.scorecards[newIdentifier] = s.scorecards[identifier]
+	delete(s.scorecards, identifier)
+	if permissions, found := s.blueprintPermissions[identifier]; found {
+		s.blueprintPermissions[newIdentifier] = permissions
+		delete(s.blueprintPermissions, identifier)
+	}
+}
+
+func (s *Server) deleteBlueprint(r *request) response {
+	identifier := r.params["blueprint"]
+	if _, found := s.blueprints[identifier]; !found {
+		return notFound("Blueprint", identifier)
+	}
+	if len(s.entities[identifier]) > 0 {
+		return hasDependents("blueprint %q has %d entities, delete them before deleting the blueprint", identifier, len(s.entities[identifier]))
+	}
+	if dependents := s.relatedBlueprints(identifier); len(dependents) > 0 {
+		return hasDependents("blueprint %q is the target of relations in blueprints %s", identifier, strings.Join(dependents, ", "))
+	}
+	s.removeBlueprint(identifier)
+	return deleted()
+}
+
+// deleteAllEntities deletes the entities of a blueprint with a migration, the migration completes immediately
+func (s *Server) deleteAllEntities(r *request) response {
+	identifier := r.params["blueprint"]
+	if _, found := s.blueprints[identifier]; !found {
+		return notFound("Blueprint", identifier)
+	}
+	deleteBlueprint := r.URL.Query().Get("delete_blueprint") == "true"
+	if deleteBlueprint {
+		if dependents := s.relatedBlueprints(identifier); len(dependents) > 0 {
+			return hasDependents("blueprint %q is the target of relations in blueprints %s", identifier, strings.Join(dependents, ", "))
+		}
+	}
+
+	deletedCount := len(s.entities[identifier])
+	s.entities[identifier] = make(map[string]object)
+	if deleteBlueprint {
+		s.removeBlueprint(identifier)
+	}
+
+	migrationID := s.generateID("migration")
+	s.migrations[migrationID] = s.withMeta(object{
+		"id":              migrationID,
+		"actor":           s.ClientID,
+		"sourceBlueprint": identifier,
+		"status":          "COMPLETED",
+		"deleteBlueprint": deleteBlueprint,
+		"deleteEntities":  true,
+		"successCount":    deletedCount,
+		"failureCount":    0,
+	}, nil)
+	return response{http.StatusOK, object{"ok": true, "migrationId": migrationID}}
+}
+
+func (s *Server) getMigration(r *request) response {
+	m, found := s.migrations[r.params["migration"]]
+	if !found {
+		return notFound("Migration", r.params["migration"])
+	}
+	return ok("migration", m)
+}
+
+func (s *Server) getBlueprintPermissions(r *request) response {
+	p, found := s.blueprintPermissions[r.params["blueprint"]]
+	if !found {
+		return notFound("Blueprint", r.params["blueprint"])
+	}
+	return ok("permissions", p)
+}
+
+func (s *Server) updateBlueprintPermissions(r *request) response {
+	p, found := s.blueprintPermissions[r.params["blueprint"]]
+	if !found {
+		return notFound("Blueprint", r.params["blueprint"])
+	}
+	if err := validatePermissionsBlocks(r.body); err != nil {
+		return unprocessable("%s", err)
+	}
+	merge(p, r.body)
+	return ok("permissions", p)
+}
+
+func (s *Server) removeBlueprint(identifier string) {
+	delete(s.blueprints, identifier)
+	delete(s.entities, identifier)
+	delete(s.scorecards, identifier)
+	delete(s.blueprintPermissions, identifier)
+	for pageIdentifier, page := range s.pages {
+		if page["type"] == "blueprint-entities" && page["blueprint"] == identifier {
+			delete(s.pages, pageIdentifier)
+			delete(s.pagePermissions, pageIdentifier)
+		}
+	}
+}
+
+// relatedBlueprints returns the other blueprints that have relations to the blueprint
+func (s *Server) relatedBlueprints(identifier string) []string {
+	var related []string
+	for _, key := range sortedKeys(s.blueprints) {
+		if key == identifier {
+			continue
+		}
+		relations, _ := s.blueprints[key]["relations"].(object)
+		for _, relation := range relations {
+			if relationObject, _ := relation.(object); relationObject["target"] == identifier {
+				related = append(related, key)
+				break
+			}
+		}
+	}
+	return related
+}
+
+func (s *Server) validateBlueprint(body object) (object, error) {
+	b := clone(body).(object)
+	if err := validateIdentifier("identifier", b["identifier"]); err != nil {
+		return nil, err
+	}
+	identifier := b["identifier"].(string)
+	if err := validateRequiredString("title", b["title"]); err != nil {
+		return nil, err
+	}
+	for _, field := range []string{"icon", "description"} {
+		if err := validateOptionalString(field, b[field]); err != nil {
+			return nil, err
+		}
+	}
+
+	schema, err := optionalObject("schema", b["schema"])
+	if err != nil {
+		return nil, err
+	}
+	properties, err := optionalObject("schema.properties", schema["properties"])
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range sortedKeys(properties) {
+		if err = validateBlueprintProperty(name, properties[name]); err != nil {
+			return nil, err
+		}
+	}
+	required, err := optionalArray("schema.required", schema["required"])
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range required {
+		name, _ := r.(string)
+		if _, found := properties[name]; !found {
+			return nil, fmt.Errorf("required property %v doesn't exist in the blueprint properties", r)
+		}
+	}
+	schema["properties"] = properties
+	schema["required"] = required
+	b["schema"] = schema
+
+	relations, err := optionalObject("relations", b["relations"])
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range sortedKeys(relations) {
+		relation, isObject := relations[name].(object)
+		if !isObject {
+			return nil, fmt.Errorf("relation %s must be an object", name)
+		}
+		target, _ := relation["target"].(string)
+		if _, found := s.blueprints[target]; !found && target != identifier {
+			return nil, fmt.Errorf("the target blueprint %q of relation %s doesn't exist", target, name)
+		}
+		for _, field := range []string{"required", "many"} {
+			if err = validateOptionalBool(fmt.Sprintf("relation %s %s", name, field), relation[field]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	b["relations"] = relations
+
+	mirrorProperties, err := optionalObject("mirrorProperties", b["mirrorProperties"])
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range sortedKeys(mirrorProperties) {
+		mirror, _ := mirrorProperties[name].(object)
+		path, _ := mirror["path"].(string)
+		relation := strings.Split(path, ".")[0]
+		if _, found := relations[relation]; !found && !strings.HasPrefix(relation, "$") {
+			return nil, fmt.Errorf("the path %q of mirror property %s must start with one of the blueprint relations", path, name)
+		}
+	}
+	b["mirrorProperties"] = mirrorProperties
+
+	calculationProperties, err := optionalObject("calculationProperties", b["calculationProperties"])
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range sortedKeys(calculationProperties) {
+		calculation, _ := calculationProperties[name].(object)
+		if err = validateRequiredString(fmt.Sprintf("calculation property %s calculation", name), calculation["calculation"]); err != nil {
+			return nil, err
+		}
+		if err = validateOneOf(fmt.Sprintf("calculation property %s type", name), calculation["type"], propertyTypes); err != nil {
+			return nil, err
+		}
+	}
+	b["calculationProperties"] = calculationProperties
+
+	aggregationProperties, err := optionalObject("aggregationProperties", b["aggregationProperties"])
+	if err != nil {
+		return nil, err
+	}
+	b["aggregationProperties"] = aggregationProperties
+	return b, nil
+}
+
+func validateBlueprintProperty(name string, value any) error {
+	property, isObject := value.(object)
+	if !isObject {
+		return fmt.Errorf("property %s must be an object", name)
+	}
+	if err := validateOneOf(fmt.Sprintf("property %s type", name), property["type"], propertyTypes); err != nil {
+		return err
+	}
+	propertyType := property["type"].(string)
+	items, err := optionalObject(fmt.Sprintf("property %s items", name), property["items"])
+	if err != nil {
+		return err
+	}
+	if propertyType == "array" && items["type"] != nil {
+		if err = validateOneOf(fmt.Sprintf("property %s items type", name), items["type"], arrayItemsTypes); err != nil {
+			return err
+		}
+	}
+	if enum, isArray := property["enum"].([]any); isArray {
+		for i, e := range enum {
+			if err = validateValueType(fmt.Sprintf("property %s enum[%d]", name, i), e, propertyType, items); err != nil {
+				return err
+			}
+		}
+	}
+	return validateValueType(fmt.Sprintf("property %s default", name), property["default"], propertyType, items)
+}
+
+// catalogPageIdentifier is the identifier of the page Port creates for a blueprint, the plural of its identifier
+func catalogPageIdentifier(blueprintIdentifier string) string {
+	if strings.HasSuffix(blueprintIdentifier, "s") {
+		return blueprintIdentifier
+	}
+	return blueprintIdentifier + "s"
+}
+
+func defaultPermissionsBlock(roles ...string) object {
+	if roles == nil {
+		roles = []string{}
+	}
+	rolesArray := make([]any, len(roles))
+	for i, role := range roles {
+		rolesArray[i] = role
+	}
+	return object{"users": []any{}, "roles": rolesArray, "teams": []any{}, "ownedByTeam": false}
+}
+
+func defaultBlueprintPermissions(b object) object {
+	identifier := b["identifier"].(string)
+	moderator := fmt.Sprintf("%s-moderator", identifier)
+	updateProperties := object{}
+	for _, metadata := range []string{"$title", "$identifier", "$icon", "$team"} {
+		updateProperties[metadata] = defaultPermissionsBlock("Admin", moderator)
+	}
+	properties, _ := b["schema"].(object)["properties"].(object)
+	for name := range properties {
+		updateProperties[name] = defaultPermissionsBlock("Admin", moderator)
+	}
+	updateRelations := object{}
+	relations, _ := b["relations"].(object)
+	for name := range relations {
+		updateRelations[name] = defaultPermissionsBlock("Admin", moderator)
+	}
+	return object{
+		"entities": object{
+			"register":         defaultPermissionsBlock("Admin", moderator),
+			"unregister":       defaultPermissionsBlock("Admin", moderator),
+			"update":           defaultPermissionsBlock("Admin", moderator),
+			"updateProperties": updateProperties,
+			"updateRelations":  updateRelations,
+		},
+	}
+}
+
+// validatePermissionsBlocks checks that the users, roles and teams of every permissions block are string arrays
+func validatePermissionsBlocks(o object) error {
+	for key, value := range o {
+		switch key {
+		case "users", "roles", "teams":
+			values, isArray := value.([]any)
+			if !isArray {
+				return fmt.Errorf("%s must be an array of strings", key)
+			}
+			for _, v := range values {
+				if _, isString := v.(string); !isString {
+					return fmt.Errorf("%s must be an array of strings", key)
+				}
+			}
+		default:
+			if nested, isObject := value.(object); isObject {
+				if err := validatePermissionsBlocks(nested); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
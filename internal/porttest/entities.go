@@ -0,0 +1,411 @@
+package porttest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func (s *Server) getEntity(r *request) response {
+	entities, found := s.entities[r.params["blueprint"]]
+	if !found {
+		return notFound("Blueprint", r.params["blueprint"])
+	}
+	e, found := entities[r.params["entity"]]
+	if !found {
+		return notFound("Entity", r.params["entity"])
+	}
+	return ok("entity", e)
+}
+
+func (s *Server) createEntity(r *request) response {
+	blueprintIdentifier := r.params["blueprint"]
+	b, found := s.blueprints[blueprintIdentifier]
+	if !found {
+		return notFound("Blueprint", blueprintIdentifier)
+	}
+	if r.body["identifier"] == nil {
+		r.body["identifier"] = s.generateID("e")
+	}
+	e, err := s.validateEntity(b, r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	identifier := e["identifier"].(string)
+	existing, found := s.entities[blueprintIdentifier][identifier]
+	if found && r.URL.Query().Get("upsert") != "true" {
+		return conflict("Entity", identifier)
+	}
+
+	s.entities[blueprintIdentifier][identifier] = s.withMeta(e, existing)
+	if found {
+		return ok("entity", e)
+	}
+	return created("entity", e)
+}
+
+func (s *Server) updateEntity(r *request) response {
+	blueprintIdentifier := r.params["blueprint"]
+	b, found := s.blueprints[blueprintIdentifier]
+	if !found {
+		return notFound("Blueprint", blueprintIdentifier)
+	}
+	identifier := r.params["entity"]
+	existing, found := s.entities[blueprintIdentifier][identifier]
+	if !found {
+		return notFound("Entity", identifier)
+	}
+	if r.body["identifier"] == nil {
+		r.body["identifier"] = identifier
+	}
+	e, err := s.validateEntity(b, r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	newIdentifier := e["identifier"].(string)
+	if newIdentifier != identifier {
+		if _, found := s.entities[blueprintIdentifier][newIdentifier]; found {
+			return conflict("Entity", newIdentifier)
+		}
+		delete(s.entities[blueprintIdentifier], identifier)
+	}
+	s.entities[blueprintIdentifier][newIdentifier] = s.withMeta(e, existing)
+	return ok("entity", e)
+}
+
+func (s *Server) deleteEntity(r *request) response {
+	blueprintIdentifier := r.params["blueprint"]
+	entities, found := s.entities[blueprintIdentifier]
+	if !found {
+		return notFound("Blueprint", blueprintIdentifier)
+	}
+	identifier := r.params["entity"]
+	if _, found = entities[identifier]; !found {
+		return notFound("Entity", identifier)
+	}
+	delete(entities, identifier)
+	return deleted()
+}
+
+// validateEntity checks an entity against the schema and relations of its blueprint and applies the property defaults
+func (s *Server) validateEntity(b object, body object) (object, error) {
+	e := clone(body).(object)
+	if err := validateIdentifier("identifier", e["identifier"]); err != nil {
+		return nil, err
+	}
+	if err := validateOptionalString("title", e["title"]); err != nil {
+		return nil, err
+	}
+	if e["title"] == nil {
+		e["title"] = e["identifier"]
+	}
+	e["blueprint"] = b["identifier"]
+
+	team, err := optionalArray("team", e["team"])
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range team {
+		name, _ := t.(string)
+		if _, found := s.teams[name]; !found {
+			return nil, fmt.Errorf("team %v doesn't exist", t)
+		}
+	}
+	e["team"] = team
+
+	schema, _ := b["schema"].(object)
+	blueprintProperties, _ := schema["properties"].(object)
+	properties, err := optionalObject("properties", e["properties"])
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range sortedKeys(properties) {
+		property, found := blueprintProperties[name].(object)
+		if !found {
+			return nil, fmt.Errorf("property %s doesn't exist in blueprint %v", name, b["identifier"])
+		}
+		items, _ := property["items"].(object)
+		if err = validateValueType(fmt.Sprintf("property %s", name), properties[name], property["type"].(string), items); err != nil {
+			return nil, err
+		}
+		if enum, isArray := property["enum"].([]any); isArray && properties[name] != nil && !containsValue(enum, properties[name]) {
+			return nil, fmt.Errorf("property %s must be one of %v", name, enum)
+		}
+	}
+	for _, name := range sortedKeys(blueprintProperties) {
+		property, _ := blueprintProperties[name].(object)
+		if properties[name] == nil && property["default"] != nil {
+			properties[name] = clone(property["default"])
+		}
+	}
+	required, _ := schema["required"].([]any)
+	for _, r := range required {
+		name, _ := r.(string)
+		if properties[name] == nil {
+			return nil, fmt.Errorf("required property %s is missing", name)
+		}
+	}
+	e["properties"] = properties
+
+	blueprintRelations, _ := b["relations"].(object)
+	relations, err := optionalObject("relations", e["relations"])
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range sortedKeys(relations) {
+		relation, found := blueprintRelations[name].(object)
+		if !found {
+			return nil, fmt.Errorf("relation %s doesn't exist in blueprint %v", name, b["identifier"])
+		}
+		if err = s.validateRelationValue(name, relation, relations[name]); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range sortedKeys(blueprintRelations) {
+		relation, _ := blueprintRelations[name].(object)
+		if relation["required"] == true && relations[name] == nil {
+			return nil, fmt.Errorf("required relation %s is missing", name)
+		}
+	}
+	e["relations"] = relations
+	return e, nil
+}
+
+func (s *Server) validateRelationValue(name string, relation object, value any) error {
+	if value == nil {
+		return nil
+	}
+	var targets []any
+	if relation["many"] == true {
+		var isArray bool
+		if targets, isArray = value.([]any); !isArray {
+			return fmt.Errorf("relation %s is a many relation and must be an array of entity identifiers", name)
+		}
+	} else {
+		targets = []any{value}
+	}
+	targetBlueprint, _ := relation["target"].(string)
+	for _, target := range targets {
+		identifier, isString := target.(string)
+		if !isString {
+			return fmt.Errorf("relation %s must reference entity identifiers", name)
+		}
+		if _, found := s.entities[targetBlueprint][identifier]; !found {
+			return fmt.Errorf("relation %s references entity %q which doesn't exist in blueprint %s", name, identifier, targetBlueprint)
+		}
+	}
+	return nil
+}
+
+// searchEntities evaluates a Port search query, see https://docs.getport.io/search-and-query/
+func (s *Server) searchEntities(r *request) response {
+	if err := validateQuery(r.body); err != nil {
+		return badRequest("%s", err)
+	}
+
+	matchingBlueprints := []any{}
+	entities := []any{}
+	for _, blueprintIdentifier := range sortedKeys(s.entities) {
+		blueprintMatched := false
+		for _, identifier := range sortedKeys(s.entities[blueprintIdentifier]) {
+			e := s.entities[blueprintIdentifier][identifier]
+			if !matchesQuery(r.body, e) {
+				continue
+			}
+			blueprintMatched = true
+			entities = append(entities, e)
+		}
+		if blueprintMatched {
+			matchingBlueprints = append(matchingBlueprints, blueprintIdentifier)
+		}
+	}
+	return response{http.StatusOK, object{"ok": true, "matchingBlueprints": matchingBlueprints, "entities": clone(entities)}}
+}
+
+// defaultSearchLimit is the number of entities in a page of the blueprint entities search when no limit is set
+const defaultSearchLimit = 200
+
+// searchBlueprintEntities returns a page of the entities of a blueprint, the cursor of the next page is the index of
+// its first entity
+func (s *Server) searchBlueprintEntities(r *request) response {
+	blueprintIdentifier := r.params["blueprint"]
+	if _, found := s.blueprints[blueprintIdentifier]; !found {
+		return notFound("Blueprint", blueprintIdentifier)
+	}
+	if query, isObject := r.body["query"].(object); isObject {
+		if err := validateQuery(query); err != nil {
+			return badRequest("%s", err)
+		}
+	}
+	limit := defaultSearchLimit
+	if l, isNumber := r.body["limit"].(float64); isNumber {
+		if l < 1 || l > 1000 {
+			return badRequest("limit must be between 1 and 1000")
+		}
+		limit = int(l)
+	}
+	from := 0
+	if f, isString := r.body["from"].(string); isString {
+		var err error
+		if from, err = strconv.Atoi(f); err != nil {
+			return badRequest("invalid from cursor %q", f)
+		}
+	}
+	include, _ := r.body["include"].([]any)
+
+	var matching []object
+	for _, identifier := range sortedKeys(s.entities[blueprintIdentifier]) {
+		e := s.entities[blueprintIdentifier][identifier]
+		if query, isObject := r.body["query"].(object); isObject && !matchesQuery(query, e) {
+			continue
+		}
+		matching = append(matching, e)
+	}
+
+	entities := []any{}
+	for i := from; i < len(matching) && i < from+limit; i++ {
+		e := clone(matching[i]).(object)
+		if len(include) > 0 {
+			included := object{}
+			for _, field := range include {
+				if name, isString := field.(string); isString && e[name] != nil {
+					included[name] = e[name]
+				}
+			}
+			e = included
+		}
+		entities = append(entities, e)
+	}
+	body := object{"ok": true, "entities": entities}
+	if from+limit < len(matching) {
+		body["next"] = strconv.Itoa(from + limit)
+	}
+	return response{http.StatusOK, body}
+}
+
+func validateQuery(query object) error {
+	if err := validateOneOf("combinator", query["combinator"], queryCombinators); err != nil {
+		return err
+	}
+	rules, isArray := query["rules"].([]any)
+	if !isArray {
+		return fmt.Errorf("rules must be an array")
+	}
+	for i, rule := range rules {
+		ruleObject, isObject := rule.(object)
+		if !isObject {
+			return fmt.Errorf("rules[%d] must be an object", i)
+		}
+		if ruleObject["combinator"] != nil {
+			if err := validateQuery(ruleObject); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := validateRequiredString(fmt.Sprintf("rules[%d].operator", i), ruleObject["operator"]); err != nil {
+			return err
+		}
+		if err := validateRequiredString(fmt.Sprintf("rules[%d].property", i), ruleObject["property"]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func matchesQuery(query object, e object) bool {
+	rules, _ := query["rules"].([]any)
+	all := query["combinator"] == "and"
+	for _, rule := range rules {
+		ruleObject := rule.(object)
+		var matched bool
+		if ruleObject["combinator"] != nil {
+			matched = matchesQuery(ruleObject, e)
+		} else {
+			matched = matchesRule(ruleObject, e)
+		}
+		if matched != all {
+			return matched
+		}
+	}
+	return all
+}
+
+func matchesRule(rule object, e object) bool {
+	value := entityField(e, rule["property"].(string))
+	switch rule["operator"] {
+	case "=":
+		return equalValues(value, rule["value"])
+	case "!=":
+		return !equalValues(value, rule["value"])
+	case "in":
+		values, _ := rule["value"].([]any)
+		return containsValue(values, value)
+	case "notIn":
+		values, _ := rule["value"].([]any)
+		return !containsValue(values, value)
+	case "contains":
+		if values, isArray := value.([]any); isArray {
+			return containsValue(values, rule["value"])
+		}
+		s, _ := value.(string)
+		substring, _ := rule["value"].(string)
+		return strings.Contains(s, substring)
+	case "beginsWith":
+		s, _ := value.(string)
+		prefix, _ := rule["value"].(string)
+		return strings.HasPrefix(s, prefix)
+	case "endsWith":
+		s, _ := value.(string)
+		suffix, _ := rule["value"].(string)
+		return strings.HasSuffix(s, suffix)
+	case "isEmpty":
+		return isEmptyValue(value)
+	case "isNotEmpty":
+		return !isEmptyValue(value)
+	}
+	return false
+}
+
+// entityField returns the value of a search rule property, the meta properties are prefixed with $
+func entityField(e object, property string) any {
+	switch property {
+	case "$identifier", "$title", "$blueprint", "$team", "$createdAt", "$updatedAt":
+		return e[strings.TrimPrefix(property, "$")]
+	}
+	if properties, _ := e["properties"].(object); properties[property] != nil {
+		return properties[property]
+	}
+	relations, _ := e["relations"].(object)
+	return relations[property]
+}
+
+func equalValues(a any, b any) bool {
+	if values, isArray := a.([]any); isArray {
+		return containsValue(values, b)
+	}
+	return fmt.Sprint(a) == fmt.Sprint(b)
+}
+
+func containsValue(values []any, value any) bool {
+	for _, v := range values {
+		if equalValues(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func isEmptyValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case object:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package porttest
+
+import "fmt"
+
+// getSidebar returns the folders of a sidebar, the pages of the sidebar aren't included as the provider doesn't read
+// them from there
+func (s *Server) getSidebar(r *request) response {
+	folders, found := s.folders[r.params["sidebar"]]
+	if !found {
+		return notFound("Sidebar", r.params["sidebar"])
+	}
+	items := make([]any, len(folders))
+	for i, f := range folders {
+		items[i] = f
+	}
+	return ok("sidebar", object{"identifier": r.params["sidebar"], "items": items})
+}
+
+func (s *Server) createFolder(r *request) response {
+	sidebar := r.params["sidebar"]
+	if _, found := s.folders[sidebar]; !found {
+		return notFound("Sidebar", sidebar)
+	}
+	f, err := s.validateFolder(sidebar, r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	identifier := f["identifier"].(string)
+	if s.findFolder(identifier) != nil {
+		return conflict("Folder", identifier)
+	}
+	s.folders[sidebar] = append(s.folders[sidebar], s.withMeta(f, nil))
+	return created("folder", f)
+}
+
+func (s *Server) updateFolder(r *request) response {
+	sidebar := r.params["sidebar"]
+	i := s.folderIndex(sidebar, r.params["folder"])
+	if i < 0 {
+		return notFound("Folder", r.params["folder"])
+	}
+	existing := s.folders[sidebar][i]
+	f := clone(existing).(object)
+	merge(f, r.body)
+	if f["identifier"] != existing["identifier"] {
+		return unprocessable("the identifier of folder %q can't be changed", existing["identifier"])
+	}
+	f, err := s.validateFolder(sidebar, f)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	s.folders[sidebar][i] = s.withMeta(f, existing)
+	return ok("folder", f)
+}
+
+func (s *Server) deleteFolder(r *request) response {
+	sidebar := r.params["sidebar"]
+	identifier := r.params["folder"]
+	i := s.folderIndex(sidebar, identifier)
+	if i < 0 {
+		return notFound("Folder", identifier)
+	}
+	for _, key := range sortedKeys(s.pages) {
+		if s.pages[key]["parent"] == identifier {
+			return hasDependents("folder %q has pages, move or delete them before deleting the folder", identifier)
+		}
+	}
+	for _, f := range s.folders[sidebar] {
+		if f["parent"] == identifier {
+			return hasDependents("folder %q has folders, move or delete them before deleting the folder", identifier)
+		}
+	}
+	s.folders[sidebar] = append(s.folders[sidebar][:i], s.folders[sidebar][i+1:]...)
+	return deleted()
+}
+
+func (s *Server) validateFolder(sidebar string, body object) (object, error) {
+	f := clone(body).(object)
+	if err := validateIdentifier("identifier", f["identifier"]); err != nil {
+		return nil, err
+	}
+	for _, field := range []string{"title", "parent", "after"} {
+		if err := validateOptionalString(field, f[field]); err != nil {
+			return nil, err
+		}
+	}
+	if parent, isString := f["parent"].(string); isString {
+		if parent == f["identifier"] || s.folderIndex(sidebar, parent) < 0 {
+			return nil, fmt.Errorf("the parent folder %q doesn't exist in sidebar %s", parent, sidebar)
+		}
+	}
+	// the sidebar is implied by the path
+	delete(f, "sidebar")
+	f["sidebarType"] = "folder"
+	return f, nil
+}
+
+func (s *Server) folderIndex(sidebar string, identifier string) int {
+	for i, f := range s.folders[sidebar] {
+		if f["identifier"] == identifier {
+			return i
+		}
+	}
+	return -1
+}
+
+// findFolder looks a folder up in all the sidebars, folder identifiers are unique in the organization
+func (s *Server) findFolder(identifier string) object {
+	for _, sidebar := range sortedKeys(s.folders) {
+		if i := s.folderIndex(sidebar, identifier); i >= 0 {
+			return s.folders[sidebar][i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package porttest
+
+import "fmt"
+
+func (s *Server) getIntegration(r *request) response {
+	i, found := s.integrations[r.params["integration"]]
+	if !found {
+		return notFound("Integration", r.params["integration"])
+	}
+	return ok("integration", i)
+}
+
+func (s *Server) createIntegration(r *request) response {
+	i, err := validateIntegration(r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	installationID := i["installationId"].(string)
+	if _, found := s.integrations[installationID]; found {
+		return conflict("Integration", installationID)
+	}
+	s.integrations[installationID] = s.withMeta(i, nil)
+	return created("integration", i)
+}
+
+func (s *Server) updateIntegration(r *request) response {
+	installationID := r.params["integration"]
+	existing, found := s.integrations[installationID]
+	if !found {
+		return notFound("Integration", installationID)
+	}
+	i := clone(existing).(object)
+	merge(i, r.body)
+	if i["installationId"] != installationID {
+		return unprocessable("the installationId of integration %q can't be changed", installationID)
+	}
+	i, err := validateIntegration(i)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	s.integrations[installationID] = s.withMeta(i, existing)
+	return ok("integration", i)
+}
+
+func (s *Server) deleteIntegration(r *request) response {
+	installationID := r.params["integration"]
+	if _, found := s.integrations[installationID]; !found {
+		return notFound("Integration", installationID)
+	}
+	delete(s.integrations, installationID)
+	return deleted()
+}
+
+func validateIntegration(body object) (object, error) {
+	i := clone(body).(object)
+	if err := validateIdentifier("installationId", i["installationId"]); err != nil {
+		return nil, err
+	}
+	for _, field := range []string{"title", "installationAppType", "version"} {
+		if err := validateOptionalString(field, i[field]); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := optionalObject("config", i["config"]); err != nil {
+		return nil, err
+	}
+	if i["changelogDestination"] != nil {
+		destination, isObject := i["changelogDestination"].(object)
+		if !isObject {
+			return nil, fmt.Errorf("changelogDestination must be an object")
+		}
+		if err := validateOneOf("changelogDestination.type", destination["type"], []string{"KAFKA", "WEBHOOK"}); err != nil {
+			return nil, err
+		}
+		if destination["type"] == "WEBHOOK" {
+			if err := validateRequiredString("changelogDestination.url", destination["url"]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return i, nil
+}
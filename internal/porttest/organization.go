@@ -0,0 +1,45 @@
+package porttest
+
+import "net/http"
+
+func (s *Server) getOrganization(r *request) response {
+	return ok("organization", s.organization)
+}
+
+// updateOrganization patches the organization settings, settings that are omitted are left as is
+func (s *Server) updateOrganization(r *request) response {
+	settings, err := optionalObject("settings", r.body["settings"])
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	hiddenBlueprints, err := optionalArray("settings.hiddenBlueprints", settings["hiddenBlueprints"])
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	for _, hidden := range hiddenBlueprints {
+		if err = s.validateBlueprintExists("settings.hiddenBlueprints", hidden); err != nil {
+			return unprocessable("%s", err)
+		}
+	}
+	merge(s.organization["settings"].(object), settings)
+	s.withMeta(s.organization, s.organization)
+	return ok("organization", s.organization)
+}
+
+func (s *Server) listSecrets(r *request) response {
+	return ok("secrets", s.secrets)
+}
+
+// createAppPermissions accepts the permissions of an app, the fake API doesn't enforce permissions
+func (s *Server) createAppPermissions(r *request) response {
+	permissions, err := optionalArray("permissions", r.body["permissions"])
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	for i, permission := range permissions {
+		if _, isString := permission.(string); !isString {
+			return unprocessable("permissions[%d] must be a string", i)
+		}
+	}
+	return response{http.StatusOK, object{"ok": true}}
+}
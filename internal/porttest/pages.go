@@ -0,0 +1,138 @@
+package porttest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (s *Server) listPages(r *request) response {
+	return ok("pages", sortedValues(s.pages))
+}
+
+func (s *Server) getPage(r *request) response {
+	p, found := s.pages[r.params["page"]]
+	if !found {
+		return notFound("Page", r.params["page"])
+	}
+	return ok("page", p)
+}
+
+// createPage responds with the identifier of the page only, as Port does
+func (s *Server) createPage(r *request) response {
+	p, err := s.validatePage(r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	identifier := p["identifier"].(string)
+	if _, found := s.pages[identifier]; found {
+		return conflict("Page", identifier)
+	}
+	s.pages[identifier] = s.withMeta(p, nil)
+	s.pagePermissions[identifier] = defaultPagePermissions()
+	return response{http.StatusCreated, object{"ok": true, "identifier": identifier}}
+}
+
+func (s *Server) updatePage(r *request) response {
+	identifier := r.params["page"]
+	existing, found := s.pages[identifier]
+	if !found {
+		return notFound("Page", identifier)
+	}
+	if r.body["identifier"] == nil {
+		r.body["identifier"] = identifier
+	}
+	if r.body["identifier"] != identifier {
+		return unprocessable("the identifier of page %q can't be changed", identifier)
+	}
+	if r.body["type"] == nil {
+		r.body["type"] = existing["type"]
+	}
+	if r.body["type"] != existing["type"] {
+		return unprocessable("the type of page %q can't be changed", identifier)
+	}
+	p, err := s.validatePage(r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	s.pages[identifier] = s.withMeta(p, existing)
+	return ok("page", p)
+}
+
+func (s *Server) deletePage(r *request) response {
+	identifier := r.params["page"]
+	p, found := s.pages[identifier]
+	if !found {
+		return notFound("Page", identifier)
+	}
+	if p["locked"] == true {
+		return unprocessable("page %q is locked and can't be deleted", identifier)
+	}
+	delete(s.pages, identifier)
+	delete(s.pagePermissions, identifier)
+	return deleted()
+}
+
+func (s *Server) getPagePermissions(r *request) response {
+	p, found := s.pagePermissions[r.params["page"]]
+	if !found {
+		return notFound("Page", r.params["page"])
+	}
+	return ok("permissions", p)
+}
+
+func (s *Server) updatePagePermissions(r *request) response {
+	p, found := s.pagePermissions[r.params["page"]]
+	if !found {
+		return notFound("Page", r.params["page"])
+	}
+	if err := validatePermissionsBlocks(r.body); err != nil {
+		return unprocessable("%s", err)
+	}
+	merge(p, r.body)
+	return ok("permissions", p)
+}
+
+func (s *Server) validatePage(body object) (object, error) {
+	p := clone(body).(object)
+	if err := validateIdentifier("identifier", p["identifier"]); err != nil {
+		return nil, err
+	}
+	if err := validateOneOf("type", p["type"], pageTypes); err != nil {
+		return nil, err
+	}
+	for _, field := range []string{"title", "icon", "description", "parent", "after"} {
+		if err := validateOptionalString(field, p[field]); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateOptionalBool("locked", p["locked"]); err != nil {
+		return nil, err
+	}
+	if p["type"] == "blueprint-entities" {
+		if err := s.validateBlueprintExists("blueprint", p["blueprint"]); err != nil {
+			return nil, err
+		}
+	}
+	if parent, isString := p["parent"].(string); isString && s.findFolder(parent) == nil {
+		return nil, fmt.Errorf("the parent folder %q doesn't exist", parent)
+	}
+	widgets, err := optionalArray("widgets", p["widgets"])
+	if err != nil {
+		return nil, err
+	}
+	for i, widget := range widgets {
+		widgetObject, isObject := widget.(object)
+		if !isObject {
+			return nil, fmt.Errorf("widgets[%d] must be an object", i)
+		}
+		if err = validateRequiredString(fmt.Sprintf("widgets[%d].type", i), widgetObject["type"]); err != nil {
+			return nil, err
+		}
+	}
+	p["widgets"] = widgets
+	return p, nil
+}
+
+func defaultPagePermissions() object {
+	return object{"read": object{"users": []any{}, "roles": []any{"Admin", "Member"}, "teams": []any{}}}
+}
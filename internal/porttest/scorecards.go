@@ -0,0 +1,144 @@
+package porttest
+
+import "fmt"
+
+func (s *Server) listScorecards(r *request) response {
+	scorecards := []any{}
+	for _, blueprintIdentifier := range sortedKeys(s.scorecards) {
+		scorecards = append(scorecards, sortedValues(s.scorecards[blueprintIdentifier])...)
+	}
+	return ok("scorecards", scorecards)
+}
+
+func (s *Server) getScorecard(r *request) response {
+	scorecards, found := s.scorecards[r.params["blueprint"]]
+	if !found {
+		return notFound("Blueprint", r.params["blueprint"])
+	}
+	sc, found := scorecards[r.params["scorecard"]]
+	if !found {
+		return notFound("Scorecard", r.params["scorecard"])
+	}
+	return ok("scorecard", sc)
+}
+
+func (s *Server) createScorecard(r *request) response {
+	blueprintIdentifier := r.params["blueprint"]
+	if _, found := s.blueprints[blueprintIdentifier]; !found {
+		return notFound("Blueprint", blueprintIdentifier)
+	}
+	sc, err := validateScorecard(blueprintIdentifier, r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	identifier := sc["identifier"].(string)
+	if _, found := s.scorecards[blueprintIdentifier][identifier]; found {
+		return conflict("Scorecard", identifier)
+	}
+	s.scorecards[blueprintIdentifier][identifier] = s.withMeta(sc, nil)
+	return created("scorecard", sc)
+}
+
+func (s *Server) updateScorecard(r *request) response {
+	blueprintIdentifier := r.params["blueprint"]
+	if _, found := s.blueprints[blueprintIdentifier]; !found {
+		return notFound("Blueprint", blueprintIdentifier)
+	}
+	identifier := r.params["scorecard"]
+	existing, found := s.scorecards[blueprintIdentifier][identifier]
+	if !found {
+		return notFound("Scorecard", identifier)
+	}
+	if r.body["identifier"] == nil {
+		r.body["identifier"] = identifier
+	}
+	sc, err := validateScorecard(blueprintIdentifier, r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	newIdentifier := sc["identifier"].(string)
+	if newIdentifier != identifier {
+		if _, found := s.scorecards[blueprintIdentifier][newIdentifier]; found {
+			return conflict("Scorecard", newIdentifier)
+		}
+		delete(s.scorecards[blueprintIdentifier], identifier)
+	}
+	s.scorecards[blueprintIdentifier][newIdentifier] = s.withMeta(sc, existing)
+	return ok("scorecard", sc)
+}
+
+func (s *Server) deleteScorecard(r *request) response {
+	scorecards, found := s.scorecards[r.params["blueprint"]]
+	if !found {
+		return notFound("Blueprint", r.params["blueprint"])
+	}
+	if _, found = scorecards[r.params["scorecard"]]; !found {
+		return notFound("Scorecard", r.params["scorecard"])
+	}
+	delete(scorecards, r.params["scorecard"])
+	return deleted()
+}
+
+func validateScorecard(blueprintIdentifier string, body object) (object, error) {
+	sc := clone(body).(object)
+	if err := validateIdentifier("identifier", sc["identifier"]); err != nil {
+		return nil, err
+	}
+	if err := validateRequiredString("title", sc["title"]); err != nil {
+		return nil, err
+	}
+	sc["blueprint"] = blueprintIdentifier
+
+	levels, err := optionalArray("levels", sc["levels"])
+	if err != nil {
+		return nil, err
+	}
+	if len(levels) == 0 {
+		levels = clone(defaultScorecardLevels).([]any)
+	}
+	levelTitles := make(map[string]bool)
+	for i, level := range levels {
+		levelObject, _ := level.(object)
+		if err = validateRequiredString(fmt.Sprintf("levels[%d].title", i), levelObject["title"]); err != nil {
+			return nil, err
+		}
+		if err = validateRequiredString(fmt.Sprintf("levels[%d].color", i), levelObject["color"]); err != nil {
+			return nil, err
+		}
+		levelTitles[levelObject["title"].(string)] = true
+	}
+	sc["levels"] = levels
+
+	rules, err := optionalArray("rules", sc["rules"])
+	if err != nil {
+		return nil, err
+	}
+	ruleIdentifiers := make(map[string]bool)
+	for i, rule := range rules {
+		ruleObject, _ := rule.(object)
+		if err = validateIdentifier(fmt.Sprintf("rules[%d].identifier", i), ruleObject["identifier"]); err != nil {
+			return nil, err
+		}
+		ruleIdentifier := ruleObject["identifier"].(string)
+		if ruleIdentifiers[ruleIdentifier] {
+			return nil, fmt.Errorf("rule identifier %q is used more than once", ruleIdentifier)
+		}
+		ruleIdentifiers[ruleIdentifier] = true
+		if err = validateRequiredString(fmt.Sprintf("rules[%d].title", i), ruleObject["title"]); err != nil {
+			return nil, err
+		}
+		level, _ := ruleObject["level"].(string)
+		if !levelTitles[level] {
+			return nil, fmt.Errorf("the level %q of rule %s isn't one of the scorecard levels", level, ruleIdentifier)
+		}
+		query, _ := ruleObject["query"].(object)
+		if err = validateOneOf(fmt.Sprintf("rules[%d].query.combinator", i), query["combinator"], queryCombinators); err != nil {
+			return nil, err
+		}
+		if conditions, _ := query["conditions"].([]any); len(conditions) == 0 {
+			return nil, fmt.Errorf("rules[%d].query.conditions must have at least one condition", i)
+		}
+	}
+	sc["rules"] = rules
+	return sc, nil
+}
@@ -0,0 +1,353 @@
+package porttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultClientID     = "porttest-client-id"
+	DefaultClientSecret = "porttest-client-secret"
+	accessToken         = "porttest-access-token"
+)
+
+type object = map[string]any
+
+// Server is an in-memory implementation of the Port API that the provider uses, it validates requests the way Port
+// does so that tests can run offline and without Port credentials
+type Server struct {
+	*httptest.Server
+	ClientID     string
+	ClientSecret string
+
+	mu     sync.Mutex
+	routes []route
+	nextID int
+
+	blueprints           map[string]object
+	blueprintPermissions map[string]object
+	entities             map[string]map[string]object
+	actions              map[string]object
+	actionPermissions    map[string]object
+	scorecards           map[string]map[string]object
+	webhooks             map[string]object
+	teams                map[string]object
+	pages                map[string]object
+	pagePermissions      map[string]object
+	integrations         map[string]object
+	migrations           map[string]object
+	folders              map[string][]object
+	organization         object
+	secrets              []object
+}
+
+type request struct {
+	*http.Request
+	params map[string]string
+	body   object
+}
+
+type response struct {
+	status int
+	body   object
+}
+
+type route struct {
+	method   string
+	segments []string
+	handler  func(r *request) response
+}
+
+// NewServer starts a fake Port API, the caller should Close it when done
+func NewServer() *Server {
+	s := &Server{
+		ClientID:             DefaultClientID,
+		ClientSecret:         DefaultClientSecret,
+		blueprints:           make(map[string]object),
+		blueprintPermissions: make(map[string]object),
+		entities:             make(map[string]map[string]object),
+		actions:              make(map[string]object),
+		actionPermissions:    make(map[string]object),
+		scorecards:           make(map[string]map[string]object),
+		webhooks:             make(map[string]object),
+		teams:                make(map[string]object),
+		pages:                make(map[string]object),
+		pagePermissions:      make(map[string]object),
+		integrations:         make(map[string]object),
+		migrations:           make(map[string]object),
+		folders:              map[string][]object{"catalog": {}},
+		organization: object{
+			"id":       "org_porttest",
+			"name":     "porttest",
+			"settings": object{},
+		},
+	}
+	s.registerRoutes()
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// AddOrganizationSecret adds a secret to the organization, secrets can only be created in the Port UI
+func (s *Server) AddOrganizationSecret(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.secrets = append(s.secrets, object{"secretName": name})
+}
+
+func (s *Server) registerRoutes() {
+	s.handle(http.MethodPost, "v1/auth/access_token", s.accessToken)
+
+	s.handle(http.MethodGet, "v1/blueprints", s.listBlueprints)
+	s.handle(http.MethodPost, "v1/blueprints", s.createBlueprint)
+	s.handle(http.MethodGet, "v1/blueprints/{blueprint}", s.getBlueprint)
+	s.handle(http.MethodPut, "v1/blueprints/{blueprint}", s.updateBlueprint)
+	s.handle(http.MethodDelete, "v1/blueprints/{blueprint}", s.deleteBlueprint)
+	s.handle(http.MethodDelete, "v1/blueprints/{blueprint}/all-entities", s.deleteAllEntities)
+	s.handle(http.MethodGet, "v1/blueprints/{blueprint}/permissions", s.getBlueprintPermissions)
+	s.handle(http.MethodPatch, "v1/blueprints/{blueprint}/permissions", s.updateBlueprintPermissions)
+	s.handle(http.MethodGet, "v1/migrations/{migration}", s.getMigration)
+
+	s.handle(http.MethodPost, "v1/blueprints/{blueprint}/entities", s.createEntity)
+	s.handle(http.MethodGet, "v1/blueprints/{blueprint}/entities/{entity}", s.getEntity)
+	s.handle(http.MethodPut, "v1/blueprints/{blueprint}/entities/{entity}", s.updateEntity)
+	s.handle(http.MethodDelete, "v1/blueprints/{blueprint}/entities/{entity}", s.deleteEntity)
+	s.handle(http.MethodPost, "v1/blueprints/{blueprint}/entities/search", s.searchBlueprintEntities)
+	s.handle(http.MethodPost, "v1/entities/search", s.searchEntities)
+
+	s.handle(http.MethodGet, "v1/actions", s.listActions)
+	s.handle(http.MethodPost, "v1/actions", s.createAction)
+	s.handle(http.MethodGet, "v1/actions/{action}", s.getAction)
+	s.handle(http.MethodPut, "v1/actions/{action}", s.updateAction)
+	s.handle(http.MethodDelete, "v1/actions/{action}", s.deleteAction)
+	s.handle(http.MethodGet, "v1/actions/{action}/permissions", s.getActionPermissions)
+	s.handle(http.MethodPatch, "v1/actions/{action}/permissions", s.updateActionPermissions)
+
+	s.handle(http.MethodGet, "v1/scorecards", s.listScorecards)
+	s.handle(http.MethodPost, "v1/blueprints/{blueprint}/scorecards", s.createScorecard)
+	s.handle(http.MethodGet, "v1/blueprints/{blueprint}/scorecards/{scorecard}", s.getScorecard)
+	s.handle(http.MethodPut, "v1/blueprints/{blueprint}/scorecards/{scorecard}", s.updateScorecard)
+	s.handle(http.MethodDelete, "v1/blueprints/{blueprint}/scorecards/{scorecard}", s.deleteScorecard)
+
+	s.handle(http.MethodGet, "v1/webhooks", s.listWebhooks)
+	s.handle(http.MethodPost, "v1/webhooks", s.createWebhook)
+	s.handle(http.MethodGet, "v1/webhooks/{webhook}", s.getWebhook)
+	s.handle(http.MethodPut, "v1/webhooks/{webhook}", s.updateWebhook)
+	s.handle(http.MethodDelete, "v1/webhooks/{webhook}", s.deleteWebhook)
+
+	s.handle(http.MethodGet, "v1/teams", s.listTeams)
+	s.handle(http.MethodPost, "v1/teams", s.createTeam)
+	s.handle(http.MethodGet, "v1/teams/{team}", s.getTeam)
+	s.handle(http.MethodPut, "v1/teams/{team}", s.updateTeam)
+	s.handle(http.MethodDelete, "v1/teams/{team}", s.deleteTeam)
+
+	s.handle(http.MethodGet, "v1/pages", s.listPages)
+	s.handle(http.MethodPost, "v1/pages", s.createPage)
+	s.handle(http.MethodGet, "v1/pages/{page}", s.getPage)
+	s.handle(http.MethodPut, "v1/pages/{page}", s.updatePage)
+	s.handle(http.MethodDelete, "v1/pages/{page}", s.deletePage)
+	s.handle(http.MethodGet, "v1/pages/{page}/permissions", s.getPagePermissions)
+	s.handle(http.MethodPatch, "v1/pages/{page}/permissions", s.updatePagePermissions)
+
+	s.handle(http.MethodGet, "v1/sidebars/{sidebar}", s.getSidebar)
+	s.handle(http.MethodPost, "v1/sidebars/{sidebar}/folders", s.createFolder)
+	s.handle(http.MethodPatch, "v1/sidebars/{sidebar}/folders/{folder}", s.updateFolder)
+	s.handle(http.MethodDelete, "v1/sidebars/{sidebar}/folders/{folder}", s.deleteFolder)
+
+	s.handle(http.MethodPost, "v1/integration", s.createIntegration)
+	s.handle(http.MethodGet, "v1/integration/{integration}", s.getIntegration)
+	s.handle(http.MethodPatch, "v1/integration/{integration}", s.updateIntegration)
+	s.handle(http.MethodDelete, "v1/integration/{integration}", s.deleteIntegration)
+
+	s.handle(http.MethodGet, "v1/organization", s.getOrganization)
+	s.handle(http.MethodPatch, "v1/organization", s.updateOrganization)
+	s.handle(http.MethodGet, "v1/organization/secrets", s.listSecrets)
+	s.handle(http.MethodPost, "v1/apps/{app}/permissions", s.createAppPermissions)
+}
+
+func (s *Server) handle(method string, pattern string, handler func(r *request) response) {
+	s.routes = append(s.routes, route{method: method, segments: strings.Split(pattern, "/"), handler: handler})
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	resp := s.serve(r)
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(resp.status)
+	_ = json.NewEncoder(w).Encode(resp.body)
+}
+
+func (s *Server) serve(r *http.Request) response {
+	var segments []string
+	for _, segment := range strings.Split(strings.Trim(r.URL.EscapedPath(), "/"), "/") {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return badRequest("invalid path %s", r.URL.EscapedPath())
+		}
+		segments = append(segments, unescaped)
+	}
+
+	pathMatched := false
+	for _, rt := range s.routes {
+		params, ok := matchSegments(rt.segments, segments)
+		if !ok {
+			continue
+		}
+		pathMatched = true
+		if rt.method != r.Method {
+			continue
+		}
+
+		if rt.segments[1] != "auth" && r.Header.Get("Authorization") != "Bearer "+accessToken {
+			return response{http.StatusUnauthorized, object{"ok": false, "error": "unauthorized", "message": "Unauthorized"}}
+		}
+
+		req := &request{Request: r, params: params}
+		if r.Body != nil && r.ContentLength != 0 {
+			if err := json.NewDecoder(r.Body).Decode(&req.body); err != nil {
+				return badRequest("request body must be a JSON object: %s", err.Error())
+			}
+		}
+		if req.body == nil {
+			req.body = object{}
+		}
+		return rt.handler(req)
+	}
+
+	if pathMatched {
+		return response{http.StatusMethodNotAllowed, object{"ok": false, "error": "method_not_allowed", "message": fmt.Sprintf("%s is not supported for %s", r.Method, r.URL.Path)}}
+	}
+	return response{http.StatusNotFound, object{"ok": false, "error": "not_found", "message": fmt.Sprintf("route %s %s was not found", r.Method, r.URL.Path)}}
+}
+
+func matchSegments(pattern []string, segments []string) (map[string]string, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, p := range pattern {
+		if strings.HasPrefix(p, "{") && strings.HasSuffix(p, "}") {
+			params[strings.Trim(p, "{}")] = segments[i]
+			continue
+		}
+		if p != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func (s *Server) accessToken(r *request) response {
+	if r.body["clientId"] != s.ClientID || r.body["clientSecret"] != s.ClientSecret {
+		return response{http.StatusUnauthorized, object{"ok": false, "error": "invalid_credentials", "message": "Client ID or secret are invalid"}}
+	}
+	return response{http.StatusOK, object{"ok": true, "accessToken": accessToken, "expiresIn": 10800, "tokenType": "Bearer"}}
+}
+
+// generateID returns a unique id for objects whose id is generated by Port
+func (s *Server) generateID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s_%06d", prefix, s.nextID)
+}
+
+// withMeta sets the timestamps and actors of an object, created is the object it replaces if there is one
+func (s *Server) withMeta(o object, existing object) object {
+	now := time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+	if existing != nil {
+		o["createdAt"] = existing["createdAt"]
+		o["createdBy"] = existing["createdBy"]
+	} else {
+		o["createdAt"] = now
+		o["createdBy"] = s.ClientID
+	}
+	o["updatedAt"] = now
+	o["updatedBy"] = s.ClientID
+	return o
+}
+
+func ok(key string, value any) response {
+	return response{http.StatusOK, object{"ok": true, key: clone(value)}}
+}
+
+func created(key string, value any) response {
+	return response{http.StatusCreated, object{"ok": true, key: clone(value)}}
+}
+
+func deleted() response {
+	return response{http.StatusOK, object{"ok": true}}
+}
+
+func badRequest(format string, args ...any) response {
+	return response{http.StatusBadRequest, object{"ok": false, "error": "invalid_request", "message": fmt.Sprintf(format, args...)}}
+}
+
+func unprocessable(format string, args ...any) response {
+	return response{http.StatusUnprocessableEntity, object{"ok": false, "error": "invalid_request", "message": fmt.Sprintf(format, args...)}}
+}
+
+func notFound(kind string, identifier string) response {
+	return response{http.StatusNotFound, object{"ok": false, "error": "not_found", "message": fmt.Sprintf("%s with identifier %q was not found", kind, identifier)}}
+}
+
+func conflict(kind string, identifier string) response {
+	return response{http.StatusConflict, object{"ok": false, "error": "identifier_taken", "message": fmt.Sprintf("%s with identifier %q already exists", kind, identifier)}}
+}
+
+func hasDependents(format string, args ...any) response {
+	return response{http.StatusUnprocessableEntity, object{"ok": false, "error": "has_dependents", "message": fmt.Sprintf(format, args...)}}
+}
+
+// clone deep copies JSON values so that callers can't change the stored objects
+func clone(v any) any {
+	switch v := v.(type) {
+	case object:
+		c := make(object, len(v))
+		for key, value := range v {
+			c[key] = clone(value)
+		}
+		return c
+	case []any:
+		c := make([]any, len(v))
+		for i, value := range v {
+			c[i] = clone(value)
+		}
+		return c
+	case []object:
+		c := make([]any, len(v))
+		for i, value := range v {
+			c[i] = clone(value)
+		}
+		return c
+	}
+	return v
+}
+
+// sortedValues returns the objects of a collection ordered by their keys, the order Port lists them in
+func sortedValues(collection map[string]object) []any {
+	keys := sortedKeys(collection)
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = collection[key]
+	}
+	return values
+}
+
+// merge applies a partial update to an object, nested objects are merged and other values are replaced
+func merge(target object, patch object) {
+	for key, value := range patch {
+		patchObject, isObject := value.(object)
+		targetObject, targetIsObject := target[key].(object)
+		if isObject && targetIsObject {
+			merge(targetObject, patchObject)
+			continue
+		}
+		target[key] = clone(value)
+	}
+}
@@ -0,0 +1,240 @@
+package porttest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/porttest"
+)
+
+func newClient(t *testing.T) (*cli.PortClient, *porttest.Server) {
+	server := porttest.NewServer()
+	t.Cleanup(server.Close)
+	portClient, err := cli.New(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = portClient.Authenticate(context.Background(), server.ClientID, server.ClientSecret); err != nil {
+		t.Fatal(err)
+	}
+	return portClient, server
+}
+
+func TestAuthentication(t *testing.T) {
+	server := porttest.NewServer()
+	defer server.Close()
+	portClient, err := cli.New(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if token, _ := portClient.Authenticate(ctx, server.ClientID, "wrong-secret"); token != "" {
+		t.Error("expected authentication with a wrong secret not to return a token")
+	}
+	if _, statusCode, _ := portClient.ReadBlueprint(ctx, "service"); statusCode != http.StatusUnauthorized {
+		t.Errorf("expected an unauthenticated request to fail with %d, got %d", http.StatusUnauthorized, statusCode)
+	}
+}
+
+func TestBlueprintAndEntityLifecycle(t *testing.T) {
+	portClient, _ := newClient(t)
+	ctx := context.Background()
+
+	blueprint := &cli.Blueprint{
+		Identifier: "service",
+		Title:      "Service",
+		Schema: cli.BlueprintSchema{
+			Properties: map[string]cli.BlueprintProperty{
+				"language": {Type: "string", Enum: []any{"Go", "Python"}},
+				"replicas": {Type: "number", Default: float64(1)},
+			},
+			Required: []string{"language"},
+		},
+	}
+	if _, err := portClient.CreateBlueprint(ctx, blueprint, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := portClient.CreateBlueprint(ctx, blueprint, nil); err == nil {
+		t.Error("expected creating a blueprint with an existing identifier to fail")
+	}
+	if _, statusCode, err := portClient.GetPage(ctx, "services"); err != nil {
+		t.Errorf("expected the catalog page of the blueprint to be created, got %d: %s", statusCode, err)
+	}
+
+	invalidEntities := map[string]*cli.Entity{
+		"a missing required property": {Blueprint: "service", Identifier: "e1"},
+		"a value outside the enum":    {Blueprint: "service", Identifier: "e1", Properties: map[string]any{"language": "Rust"}},
+		"a property of the wrong type": {
+			Blueprint: "service", Identifier: "e1", Properties: map[string]any{"language": "Go", "replicas": "two"},
+		},
+		"an unknown property":   {Blueprint: "service", Identifier: "e1", Properties: map[string]any{"language": "Go", "owner": "me"}},
+		"an invalid identifier": {Blueprint: "service", Identifier: "not valid", Properties: map[string]any{"language": "Go"}},
+	}
+	for name, e := range invalidEntities {
+		if _, err := portClient.CreateEntity(ctx, e, ""); err == nil {
+			t.Errorf("expected creating an entity with %s to fail", name)
+		}
+	}
+
+	e, err := portClient.CreateEntity(ctx, &cli.Entity{Blueprint: "service", Identifier: "api", Title: "API", Properties: map[string]any{"language": "Go"}}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Properties["replicas"] != float64(1) {
+		t.Errorf("expected the default of replicas to be applied, got %v", e.Properties["replicas"])
+	}
+	if e.CreatedAt == nil || e.CreatedBy == "" {
+		t.Error("expected the entity to have its creation metadata set")
+	}
+
+	query := map[string]any{
+		"combinator": "and",
+		"rules": []any{
+			map[string]any{"property": "$blueprint", "operator": "=", "value": "service"},
+			map[string]any{"combinator": "or", "rules": []any{
+				map[string]any{"property": "language", "operator": "=", "value": "Go"},
+				map[string]any{"property": "$identifier", "operator": "beginsWith", "value": "web"},
+			}},
+		},
+	}
+	result, err := portClient.Search(ctx, &cli.SearchRequestQuery{Query: &query})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Entities) != 1 || result.Entities[0].Identifier != "api" {
+		t.Errorf("expected the search to match the api entity, got %+v", result.Entities)
+	}
+
+	if err = portClient.DeleteBlueprint(ctx, "service"); err == nil {
+		t.Error("expected deleting a blueprint that has entities to fail")
+	}
+	migrationID, err := portClient.DeleteBlueprintWithAllEntities(ctx, "service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	migration, err := portClient.GetMigration(ctx, *migrationID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if migration.Status != consts.Completed || migration.SuccessCount != 1 {
+		t.Errorf("expected the migration to complete and delete one entity, got %+v", migration)
+	}
+	if _, statusCode, _ := portClient.ReadBlueprint(ctx, "service"); statusCode != http.StatusNotFound {
+		t.Errorf("expected the blueprint to be deleted, got %d", statusCode)
+	}
+}
+
+func TestRenameBlueprintAndEntity(t *testing.T) {
+	portClient, _ := newClient(t)
+	ctx := context.Background()
+
+	for _, identifier := range []string{"service", "other"} {
+		if _, err := portClient.CreateBlueprint(ctx, &cli.Blueprint{Identifier: identifier, Title: identifier}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := portClient.CreateEntity(ctx, &cli.Entity{Blueprint: "service", Identifier: "api", Title: "API"}, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := portClient.UpdateBlueprint(ctx, &cli.Blueprint{Identifier: "other", Title: "Other"}, "service"); err == nil {
+		t.Error("expected renaming a blueprint to an existing identifier to fail")
+	}
+	if _, err := portClient.UpdateBlueprint(ctx, &cli.Blueprint{Identifier: "microservice", Title: "Microservice"}, "service"); err != nil {
+		t.Fatal(err)
+	}
+	if _, statusCode, _ := portClient.ReadBlueprint(ctx, "service"); statusCode != http.StatusNotFound {
+		t.Errorf("expected the old identifier of the blueprint to be gone, got %d", statusCode)
+	}
+	if _, err := portClient.UpdateEntity(ctx, "api", "microservice", &cli.Entity{Blueprint: "microservice", Identifier: "gateway", Title: "Gateway"}, ""); err != nil {
+		t.Fatal(err)
+	}
+	e, _, err := portClient.ReadEntity(ctx, "gateway", "microservice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Blueprint != "microservice" {
+		t.Errorf("expected the entity to move with its blueprint, got %q", e.Blueprint)
+	}
+	if _, statusCode, _ := portClient.ReadEntity(ctx, "api", "microservice"); statusCode != http.StatusNotFound {
+		t.Errorf("expected the old identifier of the entity to be gone, got %d", statusCode)
+	}
+}
+
+func TestRelations(t *testing.T) {
+	portClient, _ := newClient(t)
+	ctx := context.Background()
+	target := "environment"
+	many := true
+
+	if _, err := portClient.CreateBlueprint(ctx, &cli.Blueprint{
+		Identifier: "service",
+		Title:      "Service",
+		Relations:  map[string]cli.Relation{"environments": {Target: &target, Many: &many}},
+	}, nil); err == nil {
+		t.Fatal("expected creating a blueprint with a relation to a missing blueprint to fail")
+	}
+
+	for _, b := range []*cli.Blueprint{
+		{Identifier: "environment", Title: "Environment"},
+		{Identifier: "service", Title: "Service", Relations: map[string]cli.Relation{"environments": {Target: &target, Many: &many}}},
+	} {
+		if _, err := portClient.CreateBlueprint(ctx, b, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := portClient.CreateEntity(ctx, &cli.Entity{Blueprint: "service", Identifier: "api", Relations: map[string]any{"environments": []any{"production"}}}, ""); err == nil {
+		t.Error("expected creating an entity related to a missing entity to fail")
+	}
+	if err := portClient.DeleteBlueprint(ctx, "environment"); err == nil {
+		t.Error("expected deleting the target of a relation to fail")
+	}
+}
+
+func TestActionPermissions(t *testing.T) {
+	portClient, _ := newClient(t)
+	ctx := context.Background()
+	operation := "CREATE"
+	url := "https://example.com"
+
+	action := &cli.Action{
+		Identifier:       "deploy",
+		Trigger:          &cli.Trigger{Type: consts.SelfService, Operation: &operation, UserInputs: &cli.ActionUserInputs{}},
+		InvocationMethod: &cli.InvocationMethod{Type: consts.Webhook, Url: &url},
+	}
+	if _, err := portClient.CreateAction(ctx, action); err != nil {
+		t.Fatal(err)
+	}
+
+	permissions, _, err := portClient.GetActionPermissions(ctx, "deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	permissions.Execute.Roles = []string{"Member"}
+	if _, err = portClient.UpdateActionPermissions(ctx, "deploy", permissions); err != nil {
+		t.Fatal(err)
+	}
+	permissions, _, err = portClient.GetActionPermissions(ctx, "deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(permissions.Execute.Roles) != 1 || permissions.Execute.Roles[0] != "Member" {
+		t.Errorf("expected the execute roles to be updated, got %v", permissions.Execute.Roles)
+	}
+}
+
+func TestUnknownRoute(t *testing.T) {
+	_, server := newClient(t)
+	resp, err := http.Get(server.URL + "/v1/unknown")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected an unknown route to return %d, got %d", http.StatusNotFound, resp.StatusCode)
+	}
+}
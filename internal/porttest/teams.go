@@ -0,0 +1,93 @@
+package porttest
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (s *Server) listTeams(r *request) response {
+	return ok("teams", sortedValues(s.teams))
+}
+
+// getTeam returns the users of the team as user objects, unlike the other team endpoints that return their emails
+func (s *Server) getTeam(r *request) response {
+	t, found := s.teams[r.params["team"]]
+	if !found {
+		return notFound("Team", r.params["team"])
+	}
+	t = clone(t).(object)
+	users, _ := t["users"].([]any)
+	userObjects := make([]any, len(users))
+	for i, email := range users {
+		userObjects[i] = object{"email": email, "firstName": strings.Split(email.(string), "@")[0], "status": "Active"}
+	}
+	t["users"] = userObjects
+	return ok("team", t)
+}
+
+func (s *Server) createTeam(r *request) response {
+	t, err := validateTeam(r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	name := t["name"].(string)
+	if _, found := s.teams[name]; found {
+		return conflict("Team", name)
+	}
+	s.teams[name] = s.withMeta(t, nil)
+	return created("team", t)
+}
+
+func (s *Server) updateTeam(r *request) response {
+	name := r.params["team"]
+	existing, found := s.teams[name]
+	if !found {
+		return notFound("Team", name)
+	}
+	if r.body["name"] == nil {
+		r.body["name"] = name
+	}
+	t, err := validateTeam(r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	newName := t["name"].(string)
+	if _, found = s.teams[newName]; found && newName != name {
+		return conflict("Team", newName)
+	}
+	delete(s.teams, name)
+	s.teams[newName] = s.withMeta(t, existing)
+	return ok("team", t)
+}
+
+func (s *Server) deleteTeam(r *request) response {
+	name := r.params["team"]
+	if _, found := s.teams[name]; !found {
+		return notFound("Team", name)
+	}
+	delete(s.teams, name)
+	return deleted()
+}
+
+func validateTeam(body object) (object, error) {
+	t := clone(body).(object)
+	if err := validateRequiredString("name", t["name"]); err != nil {
+		return nil, err
+	}
+	if err := validateOptionalString("description", t["description"]); err != nil {
+		return nil, err
+	}
+	users, err := optionalArray("users", t["users"])
+	if err != nil {
+		return nil, err
+	}
+	for i, user := range users {
+		email, _ := user.(string)
+		if !strings.Contains(email, "@") {
+			return nil, fmt.Errorf("users[%d] must be an email address, got %v", i, user)
+		}
+	}
+	t["users"] = users
+	t["provider"] = "port"
+	return t, nil
+}
@@ -0,0 +1,147 @@
+package porttest
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+var identifierRegex = regexp.MustCompile(`^[A-Za-z0-9@_.+:\\/=-]+$`)
+
+const maxIdentifierLength = 100
+
+var (
+	propertyTypes          = []string{"string", "number", "boolean", "object", "array"}
+	arrayItemsTypes        = []string{"string", "number", "boolean", "object"}
+	actionOperations       = []string{"CREATE", "DAY-2", "DELETE"}
+	invocationMethodTypes  = []string{"KAFKA", "WEBHOOK", "GITHUB", "GITLAB", "AZURE_DEVOPS", "UPSERT_ENTITY"}
+	pageTypes              = []string{"blueprint-entities", "dashboard", "home", "entity", "run", "users-and-teams", "audit-log", "runs-history"}
+	queryCombinators       = []string{"and", "or"}
+	defaultScorecardLevels = []any{
+		object{"title": "Basic", "color": "paleBlue"},
+		object{"title": "Bronze", "color": "bronze"},
+		object{"title": "Silver", "color": "silver"},
+		object{"title": "Gold", "color": "gold"},
+	}
+)
+
+// validateIdentifier checks an identifier against Port's identifier rules, field is the name used in the error
+func validateIdentifier(field string, value any) error {
+	identifier, ok := value.(string)
+	if !ok || identifier == "" {
+		return fmt.Errorf("%s is required and must be a string", field)
+	}
+	if len(identifier) > maxIdentifierLength {
+		return fmt.Errorf("%s %q must be at most %d characters long", field, identifier, maxIdentifierLength)
+	}
+	if !identifierRegex.MatchString(identifier) {
+		return fmt.Errorf("%s %q must match the pattern %s", field, identifier, identifierRegex.String())
+	}
+	return nil
+}
+
+func validateRequiredString(field string, value any) error {
+	s, ok := value.(string)
+	if !ok || s == "" {
+		return fmt.Errorf("%s is required and must be a string", field)
+	}
+	return nil
+}
+
+func validateOptionalString(field string, value any) error {
+	if value == nil {
+		return nil
+	}
+	if _, ok := value.(string); !ok {
+		return fmt.Errorf("%s must be a string", field)
+	}
+	return nil
+}
+
+func validateOptionalBool(field string, value any) error {
+	if value == nil {
+		return nil
+	}
+	if _, ok := value.(bool); !ok {
+		return fmt.Errorf("%s must be a boolean", field)
+	}
+	return nil
+}
+
+func validateOneOf(field string, value any, allowed []string) error {
+	s, _ := value.(string)
+	for _, a := range allowed {
+		if s == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s must be one of %v, got %v", field, allowed, value)
+}
+
+// optionalObject returns the object value of a field, or an empty object when the field isn't set
+func optionalObject(field string, value any) (object, error) {
+	if value == nil {
+		return object{}, nil
+	}
+	o, ok := value.(object)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object", field)
+	}
+	return o, nil
+}
+
+func optionalArray(field string, value any) ([]any, error) {
+	if value == nil {
+		return []any{}, nil
+	}
+	a, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an array", field)
+	}
+	return a, nil
+}
+
+// validateValueType checks that a JSON value matches a Port property type
+func validateValueType(field string, value any, propertyType string, items object) error {
+	if value == nil {
+		return nil
+	}
+	valid := false
+	switch propertyType {
+	case "string":
+		_, valid = value.(string)
+	case "number":
+		_, valid = value.(float64)
+	case "boolean":
+		_, valid = value.(bool)
+	case "object":
+		_, valid = value.(object)
+	case "array":
+		var elements []any
+		elements, valid = value.([]any)
+		if valid {
+			itemsType, _ := items["type"].(string)
+			if itemsType == "" {
+				itemsType = "string"
+			}
+			for i, element := range elements {
+				if err := validateValueType(fmt.Sprintf("%s[%d]", field, i), element, itemsType, nil); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if !valid {
+		return fmt.Errorf("%s must be of type %s", field, propertyType)
+	}
+	return nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
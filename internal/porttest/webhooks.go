@@ -0,0 +1,109 @@
+package porttest
+
+import "fmt"
+
+func (s *Server) listWebhooks(r *request) response {
+	return ok("integrations", sortedValues(s.webhooks))
+}
+
+func (s *Server) getWebhook(r *request) response {
+	w, found := s.webhooks[r.params["webhook"]]
+	if !found {
+		return notFound("Webhook", r.params["webhook"])
+	}
+	return ok("integration", w)
+}
+
+func (s *Server) createWebhook(r *request) response {
+	w, err := s.validateWebhook(r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	identifier := w["identifier"].(string)
+	if _, found := s.webhooks[identifier]; found {
+		return conflict("Webhook", identifier)
+	}
+	w["webhookKey"] = s.generateID("key")
+	w["url"] = fmt.Sprintf("%s/%s", s.URL, w["webhookKey"])
+	s.webhooks[identifier] = s.withMeta(w, nil)
+	return created("integration", w)
+}
+
+func (s *Server) updateWebhook(r *request) response {
+	identifier := r.params["webhook"]
+	existing, found := s.webhooks[identifier]
+	if !found {
+		return notFound("Webhook", identifier)
+	}
+	if r.body["identifier"] == nil {
+		r.body["identifier"] = identifier
+	}
+	w, err := s.validateWebhook(r.body)
+	if err != nil {
+		return unprocessable("%s", err)
+	}
+	newIdentifier := w["identifier"].(string)
+	if newIdentifier != identifier {
+		if _, found := s.webhooks[newIdentifier]; found {
+			return conflict("Webhook", newIdentifier)
+		}
+		delete(s.webhooks, identifier)
+	}
+	w["webhookKey"] = existing["webhookKey"]
+	w["url"] = existing["url"]
+	s.webhooks[newIdentifier] = s.withMeta(w, existing)
+	return ok("integration", w)
+}
+
+func (s *Server) deleteWebhook(r *request) response {
+	identifier := r.params["webhook"]
+	if _, found := s.webhooks[identifier]; !found {
+		return notFound("Webhook", identifier)
+	}
+	delete(s.webhooks, identifier)
+	return deleted()
+}
+
+func (s *Server) validateWebhook(body object) (object, error) {
+	w := clone(body).(object)
+	if err := validateIdentifier("identifier", w["identifier"]); err != nil {
+		return nil, err
+	}
+	for _, field := range []string{"title", "icon", "description"} {
+		if err := validateOptionalString(field, w[field]); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateOptionalBool("enabled", w["enabled"]); err != nil {
+		return nil, err
+	}
+	if w["enabled"] == nil {
+		w["enabled"] = true
+	}
+	// Port always returns the security settings, even when none are set
+	security, err := optionalObject("security", w["security"])
+	if err != nil {
+		return nil, err
+	}
+	w["security"] = security
+
+	mappings, err := optionalArray("mappings", w["mappings"])
+	if err != nil {
+		return nil, err
+	}
+	for i, mapping := range mappings {
+		mappingObject, _ := mapping.(object)
+		if err = s.validateBlueprintExists(fmt.Sprintf("mappings[%d].blueprint", i), mappingObject["blueprint"]); err != nil {
+			return nil, err
+		}
+		entity, isObject := mappingObject["entity"].(object)
+		if !isObject {
+			return nil, fmt.Errorf("mappings[%d].entity is required and must be an object", i)
+		}
+		if err = validateRequiredString(fmt.Sprintf("mappings[%d].entity.identifier", i), entity["identifier"]); err != nil {
+			return nil, err
+		}
+	}
+	w["mappings"] = mappings
+	return w, nil
+}
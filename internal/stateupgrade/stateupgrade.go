@@ -0,0 +1,159 @@
+package stateupgrade
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+)
+
+// State is the JSON state of a resource as Terraform stored it, numbers are json.Number to keep their precision
+type State = map[string]any
+
+// Upgrader returns a resource.StateUpgrader that upgrades the raw JSON state of a prior schema version with upgrade.
+// The upgraded state only needs the attributes whose values changed, attributes that aren't in the current schema
+// are dropped and attributes that are missing are set to null.
+//
+// Working with the JSON state, rather than a prior schema, lets a single upgrader handle the different layouts a
+// resource had while its schema version stayed the same.
+func Upgrader(upgrade func(state State) error) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			state, err := decode(req.RawState)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to read the prior resource state", err.Error())
+				return
+			}
+			if err = upgrade(state); err != nil {
+				resp.Diagnostics.AddError("Unable to upgrade the resource state", err.Error())
+				return
+			}
+			if resp.State.Raw, err = encode(state, resp.State.Schema.Type().TerraformType(ctx)); err != nil {
+				resp.Diagnostics.AddError("The upgraded resource state doesn't match the resource schema", err.Error())
+			}
+		},
+	}
+}
+
+// Mover returns a resource.StateMover that moves the raw JSON state of sourceTypeName resources of this provider with
+// move, so that a moved block can migrate them to the resource without touching the API. The moved state follows
+// the same rules as the state returned by an Upgrader, and sources of other types are left to the other movers.
+func Mover(sourceTypeName string, move func(source State, sourceSchemaVersion int64) (State, error)) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != sourceTypeName || !isProviderAddress(req.SourceProviderAddress) {
+				return
+			}
+			source, err := decode(req.SourceRawState)
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Unable to read the %s state", sourceTypeName), err.Error())
+				return
+			}
+			state, err := move(source, req.SourceSchemaVersion)
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Unable to move the %s state", sourceTypeName), err.Error())
+				return
+			}
+			if resp.TargetState.Raw, err = encode(state, resp.TargetState.Schema.Type().TerraformType(ctx)); err != nil {
+				resp.Diagnostics.AddError("The moved resource state doesn't match the resource schema", err.Error())
+			}
+		},
+	}
+}
+
+// isProviderAddress reports whether a source provider address is this provider, the registry address or a local
+// mirror or development override of it with the same name
+func isProviderAddress(address string) bool {
+	return address == consts.ProviderAddress || strings.HasSuffix(address, "/"+consts.ProviderName)
+}
+
+func decode(rawState *tfprotov6.RawState) (State, error) {
+	if rawState == nil || rawState.JSON == nil {
+		return nil, fmt.Errorf("the state isn't stored as JSON, apply it with the prior provider version first")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(rawState.JSON))
+	decoder.UseNumber()
+	var state State
+	if err := decoder.Decode(&state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func encode(state State, stateType tftypes.Type) (tftypes.Value, error) {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	return tftypes.ValueFromJSONWithOpts(b, stateType, tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true})
+}
+
+// SetDefault sets an attribute that isn't set to its default, e.g. attributes that were added with a default after
+// the state was stored
+func SetDefault(o map[string]any, attribute string, value any) {
+	if o[attribute] == nil {
+		o[attribute] = value
+	}
+}
+
+// Object returns the object value of an attribute, or nil if it isn't an object
+func Object(o map[string]any, attribute string) map[string]any {
+	v, _ := o[attribute].(map[string]any)
+	return v
+}
+
+// List returns the list value of an attribute, or nil if it isn't a list
+func List(o map[string]any, attribute string) []any {
+	v, _ := o[attribute].([]any)
+	return v
+}
+
+// String returns the string value of an attribute, or an empty string if it isn't a string
+func String(o map[string]any, attribute string) string {
+	v, _ := o[attribute].(string)
+	return v
+}
+
+// BoolToString converts a boolean attribute to the string attribute that replaced it
+func BoolToString(o map[string]any, attribute string) {
+	if v, isBool := o[attribute].(bool); isBool {
+		o[attribute] = strconv.FormatBool(v)
+	}
+}
+
+// TypedValue converts a value stored as a string, the way the prior layouts stored all the property values, to the
+// JSON value of the type
+func TypedValue(value string, valueType string) any {
+	switch valueType {
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return json.Number(value)
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return value
+}
+
+// InferType infers the type of a property value that was stored as a string without its type
+func InferType(value string) string {
+	if _, err := strconv.ParseBool(value); err == nil && strings.ToLower(value) == value {
+		return "boolean"
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return "number"
+	}
+	if strings.HasPrefix(strings.TrimSpace(value), "{") && json.Valid([]byte(value)) {
+		return "object"
+	}
+	return "string"
+}
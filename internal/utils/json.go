@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// DecodeJSON decodes the numbers as json.Number, so that they keep their precision when they're encoded again
+func DecodeJSON(b []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var v any
+	err := decoder.Decode(&v)
+	return v, err
+}
+
+// ConfiguredJSONFields returns the object without the fields that aren't configured and hold a default value Port fills
+// in, so that those don't cause a diff, while any other field of the object, e.g. a property added to a blueprint
+// outside of Terraform, does. The default values are the empty ones (null, "", false, 0, [] and {}) and the values of
+// the fields in defaults. Lists of the same length are compared item by item.
+func ConfiguredJSONFields(object any, configured any, defaults map[string]any) any {
+	switch c := configured.(type) {
+	case map[string]any:
+		o, isObject := object.(map[string]any)
+		if !isObject {
+			return withoutDefaultFields(object, defaults)
+		}
+		fields := map[string]any{}
+		for key, value := range o {
+			if configuredValue, ok := c[key]; ok {
+				fields[key] = ConfiguredJSONFields(value, configuredValue, defaults)
+			} else if !isDefaultField(key, value, defaults) {
+				fields[key] = withoutDefaultFields(value, defaults)
+			}
+		}
+		for key, value := range c {
+			// Port omits the fields that are set to null
+			if _, ok := o[key]; !ok && value == nil {
+				fields[key] = nil
+			}
+		}
+		return fields
+	case []any:
+		o, isList := object.([]any)
+		if !isList || len(o) != len(c) {
+			return withoutDefaultFields(object, defaults)
+		}
+		items := make([]any, len(o))
+		for i := range o {
+			items[i] = ConfiguredJSONFields(o[i], c[i], defaults)
+		}
+		return items
+	default:
+		return withoutDefaultFields(object, defaults)
+	}
+}
+
+// withoutDefaultFields returns the object without the fields that hold a default value, as if none was configured
+func withoutDefaultFields(object any, defaults map[string]any) any {
+	switch o := object.(type) {
+	case map[string]any:
+		return ConfiguredJSONFields(o, map[string]any{}, defaults)
+	case []any:
+		items := make([]any, len(o))
+		for i := range o {
+			items[i] = withoutDefaultFields(o[i], defaults)
+		}
+		return items
+	default:
+		return object
+	}
+}
+
+func isDefaultField(key string, value any, defaults map[string]any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		if v == "" {
+			return true
+		}
+	case bool:
+		if !v {
+			return true
+		}
+	case json.Number:
+		if f, err := v.Float64(); err == nil && f == 0 {
+			return true
+		}
+	case float64:
+		if v == 0 {
+			return true
+		}
+	case map[string]any:
+		if len(v) == 0 {
+			return true
+		}
+	case []any:
+		if len(v) == 0 {
+			return true
+		}
+	}
+	defaultValue, ok := defaults[key]
+	if !ok {
+		return false
+	}
+	encodedDefault, err := json.Marshal(defaultValue)
+	if err != nil {
+		return false
+	}
+	encodedValue, err := json.Marshal(value)
+	return err == nil && string(encodedValue) == string(encodedDefault)
+}
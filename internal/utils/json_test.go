@@ -0,0 +1,127 @@
+package utils_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func TestConfiguredJSONFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		object     string
+		configured string
+		expected   string
+	}{
+		{
+			name:       "fields that aren't configured and hold a value are kept",
+			object:     `{"identifier":"service","title":"Service","icon":"Microservice"}`,
+			configured: `{"identifier":"service","title":"Service"}`,
+			expected:   `{"icon":"Microservice","identifier":"service","title":"Service"}`,
+		},
+		{
+			name:       "fields that aren't configured and hold an empty value are dropped",
+			object:     `{"title":"Service","description":"","many":false,"default":0,"enum":[],"relations":{},"team":null}`,
+			configured: `{"title":"Service"}`,
+			expected:   `{"title":"Service"}`,
+		},
+		{
+			name:       "fields that aren't configured and hold their default value are dropped",
+			object:     `{"title":"Service","ownership":{"type":"Direct"}}`,
+			configured: `{"title":"Service"}`,
+			expected:   `{"title":"Service"}`,
+		},
+		{
+			name:       "fields that aren't configured and hold another value than their default are kept",
+			object:     `{"title":"Service","ownership":{"type":"Inherited","path":"team"}}`,
+			configured: `{"title":"Service"}`,
+			expected:   `{"ownership":{"path":"team","type":"Inherited"},"title":"Service"}`,
+		},
+		{
+			name:       "items added to a collection are kept without their empty fields",
+			object:     `{"schema":{"properties":{"name":{"type":"string"},"team":{"type":"string","title":"Team","enum":[]}},"required":[]}}`,
+			configured: `{"schema":{"properties":{"name":{"type":"string"}}}}`,
+			expected:   `{"schema":{"properties":{"name":{"type":"string"},"team":{"title":"Team","type":"string"}}}}`,
+		},
+		{
+			name:       "configured fields get the values of the object",
+			object:     `{"title":"Changed","count":2}`,
+			configured: `{"title":"Service","count":1}`,
+			expected:   `{"count":2,"title":"Changed"}`,
+		},
+		{
+			name:       "nested objects",
+			object:     `{"schema":{"properties":{"name":{"type":"string","title":"Name","enum":[]}},"required":[]}}`,
+			configured: `{"schema":{"properties":{"name":{"type":"string","title":"Service name"}}}}`,
+			expected:   `{"schema":{"properties":{"name":{"title":"Name","type":"string"}}}}`,
+		},
+		{
+			name:       "empty lists and objects are kept",
+			object:     `{"required":[],"enum":[],"relations":{}}`,
+			configured: `{"required":[],"enum":[],"relations":{}}`,
+			expected:   `{"enum":[],"relations":{},"required":[]}`,
+		},
+		{
+			name:       "falsy values are kept",
+			object:     `{"many":false,"default":0,"title":""}`,
+			configured: `{"many":false,"default":0,"title":""}`,
+			expected:   `{"default":0,"many":false,"title":""}`,
+		},
+		{
+			name:       "configured null fields that the object omits stay null",
+			object:     `{"title":"Service"}`,
+			configured: `{"title":"Service","description":null}`,
+			expected:   `{"description":null,"title":"Service"}`,
+		},
+		{
+			name:       "configured fields that the object doesn't have are dropped",
+			object:     `{"title":"Service"}`,
+			configured: `{"title":"Service","description":"A service"}`,
+			expected:   `{"title":"Service"}`,
+		},
+		{
+			name:       "lists of the same length are compared item by item",
+			object:     `{"rules":[{"property":"a","operator":""},{"property":"b","operator":""}]}`,
+			configured: `{"rules":[{"property":"a"},{"property":"b"}]}`,
+			expected:   `{"rules":[{"property":"a"},{"property":"b"}]}`,
+		},
+		{
+			name:       "lists of a different length are returned without their empty fields",
+			object:     `{"rules":[{"property":"a","operator":"=","value":""}]}`,
+			configured: `{"rules":[{"property":"a"},{"property":"b"}]}`,
+			expected:   `{"rules":[{"operator":"=","property":"a"}]}`,
+		},
+		{
+			name:       "values of a different type are returned as they are",
+			object:     `{"default":"text"}`,
+			configured: `{"default":{"value":"text"}}`,
+			expected:   `{"default":"text"}`,
+		},
+		{
+			name:       "numbers keep their precision",
+			object:     `{"maximum":12345678901234567890}`,
+			configured: `{"maximum":1}`,
+			expected:   `{"maximum":12345678901234567890}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			object, err := utils.DecodeJSON([]byte(tt.object))
+			if err != nil {
+				t.Fatal(err)
+			}
+			configured, err := utils.DecodeJSON([]byte(tt.configured))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := json.Marshal(utils.ConfiguredJSONFields(object, configured, map[string]any{"ownership": map[string]any{"type": "Direct"}}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package utils
+
+import "sync"
+
+// KeyedMutex serializes operations that share the same key, e.g. read-modify-write updates of the same blueprint
+// that are done by different resources in parallel.
+type KeyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func (m *KeyedMutex) Lock(key string) func() {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = map[string]*sync.Mutex{}
+	}
+	lock, ok := m.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		m.locks[key] = lock
+	}
+	m.mu.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// BlueprintMutex is shared by the resources that update parts of an existing blueprint
+var BlueprintMutex = &KeyedMutex{}
+
+// ScorecardMutex is shared by the resources that update parts of an existing scorecard, keyed by <blueprint>:<scorecard>
+var ScorecardMutex = &KeyedMutex{}
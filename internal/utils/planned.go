@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// KeySet is a set of keys that resources planned in parallel can share, e.g. the identifiers of the blueprints that
+// have changes in the current plan.
+type KeySet struct {
+	mu   sync.Mutex
+	keys map[string]bool
+}
+
+func (s *KeySet) Add(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.keys == nil {
+		s.keys = map[string]bool{}
+	}
+	s.keys[key] = true
+}
+
+func (s *KeySet) Contains(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.keys[key]
+}
+
+// AddPlannedChange adds the planned and the current value of the string attribute when the plan creates, updates or
+// destroys the resource
+func (s *KeySet) AddPlannedChange(ctx context.Context, req resource.ModifyPlanRequest, attribute path.Path) {
+	if !HasPlannedChanges(req) {
+		return
+	}
+	var planned, current types.String
+	if !req.Plan.Raw.IsNull() && !req.Plan.GetAttribute(ctx, attribute, &planned).HasError() && !planned.IsNull() && !planned.IsUnknown() {
+		s.Add(planned.ValueString())
+	}
+	if !req.State.Raw.IsNull() && !req.State.GetAttribute(ctx, attribute, &current).HasError() && !current.IsNull() {
+		s.Add(current.ValueString())
+	}
+}
+
+// HasPlannedChanges is true when the plan creates, updates or destroys the resource
+func HasPlannedChanges(req resource.ModifyPlanRequest) bool {
+	return req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || !req.Plan.Raw.Equal(req.State.Raw)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 )
 
 func CopyGenericMaps[T any](target map[string]T, source map[string]T) {
@@ -22,7 +23,9 @@ func CopyMaps(target map[string]schema.Attribute, source map[string]schema.Attri
 	CopyGenericMaps(target, source)
 }
 
-func GenID() string {
+// IDGenerator generates the identifiers returned by GenID, the acceptance tests replace it to generate the same
+// identifiers when they replay recorded requests
+var IDGenerator = func() string {
 	id, err := uuid.GenerateUUID()
 	if err != nil {
 		panic(err)
@@ -30,6 +33,10 @@ func GenID() string {
 	return fmt.Sprintf("t-%s", id[len(id)-18:])
 }
 
+func GenID() string {
+	return IDGenerator()
+}
+
 func TerraformListToGoArray(ctx context.Context, list types.List, arrayType string) ([]interface{}, error) {
 	elems := []interface{}{}
 	for _, elem := range list.Elements() {
@@ -98,7 +105,22 @@ func GoObjectToTerraformString(v interface{}) (types.String, error) {
 	return types.StringValue(value), nil
 }
 
-func TerraformStringToGoType[T any](s types.String) (T, error) {
+// GoObjectToTerraformJson encodes the object as a JSON attribute value, null objects are null values
+func GoObjectToTerraformJson(v interface{}) (jsontypes.Normalized, error) {
+	s, err := GoObjectToTerraformString(v)
+	if err != nil {
+		return jsontypes.NewNormalizedNull(), err
+	}
+	return jsontypes.NewNormalizedPointerValue(s.ValueStringPointer()), nil
+}
+
+// terraformString is implemented by types.String and by the custom string types such as jsontypes.Normalized
+type terraformString interface {
+	IsNull() bool
+	ValueString() string
+}
+
+func TerraformStringToGoType[T any](s terraformString) (T, error) {
 	var obj T
 
 	if s.IsNull() {
@@ -0,0 +1,88 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/itchyny/gojq"
+)
+
+var _ validator.String = jqValidator{}
+
+type jqValidator struct{}
+
+// Jq returns a validator which ensures that any configured string value is a syntactically valid jq expression.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Jq() validator.String {
+	return jqValidator{}
+}
+
+func (v jqValidator) Description(ctx context.Context) string {
+	return "value must be a valid jq expression"
+}
+
+func (v jqValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jqValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidateJq(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid jq expression", err.Error())
+	}
+}
+
+// ValidateJq parses the jq expression and returns a descriptive error if it isn't valid
+func ValidateJq(expression string) error {
+	_, err := gojq.Parse(normalizeSingleQuotedStrings(expression))
+	if err == nil {
+		return nil
+	}
+
+	if parseErr, ok := err.(interface{ Token() (string, int) }); ok {
+		_, offset := parseErr.Token()
+		return fmt.Errorf("failed to parse jq expression %q: %s at position %d", expression, err.Error(), offset)
+	}
+	return fmt.Errorf("failed to parse jq expression %q: %s", expression, err.Error())
+}
+
+// normalizeSingleQuotedStrings replaces single quoted string literals (e.g. 'my-identifier'), which Port accepts as
+// strings, with double quoted ones, so they can be parsed by gojq
+func normalizeSingleQuotedStrings(expression string) string {
+	var b strings.Builder
+	inDoubleQuotes := false
+	for i := 0; i < len(expression); i++ {
+		c := expression[i]
+		switch {
+		case inDoubleQuotes:
+			b.WriteByte(c)
+			if c == '\\' && i+1 < len(expression) {
+				i++
+				b.WriteByte(expression[i])
+			} else if c == '"' {
+				inDoubleQuotes = false
+			}
+		case c == '"':
+			inDoubleQuotes = true
+			b.WriteByte(c)
+		case c == '\'':
+			end := strings.IndexByte(expression[i+1:], '\'')
+			if end == -1 {
+				// unterminated, let the parser report it
+				b.WriteString(expression[i:])
+				return b.String()
+			}
+			b.WriteString(strconv.Quote(expression[i+1 : i+1+end]))
+			i += end + 1
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,119 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateJq(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		// expectedError is empty when the expression is valid
+		expectedError string
+	}{
+		{
+			name:       "path",
+			expression: ".body.pull_request.title",
+		},
+		{
+			name:       "pipe and select",
+			expression: `.body.items | map(select(.status == "open")) | length`,
+		},
+		{
+			name:       "object construction",
+			expression: `{identifier: .body.id, title: (.body.name // "unknown")}`,
+		},
+		{
+			name:       "single quoted strings",
+			expression: `.headers['X-GitHub-Event'] == 'push'`,
+		},
+		{
+			name:       "single quote inside a double quoted string",
+			expression: `.body.title == "it's"`,
+		},
+		{
+			name:          "unexpected end",
+			expression:    ".body |",
+			expectedError: `failed to parse jq expression ".body |": unexpected EOF at position 7`,
+		},
+		{
+			name:          "unclosed bracket",
+			expression:    "[.body",
+			expectedError: `failed to parse jq expression "[.body": unexpected EOF at position 6`,
+		},
+		{
+			name:          "unexpected token",
+			expression:    "{identifier: }",
+			expectedError: `failed to parse jq expression "{identifier: }": unexpected token "}" at position 14`,
+		},
+		{
+			name:          "unterminated single quoted string",
+			expression:    "'push",
+			expectedError: `unexpected token "'"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateJq(tt.expression)
+			if tt.expectedError == "" {
+				if err != nil {
+					t.Errorf("expected %s to be valid, got %s", tt.expression, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+				t.Errorf("expected an error containing %s, got %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestNormalizeSingleQuotedStrings(t *testing.T) {
+	tests := map[string]string{
+		`.a == 'b'`:            `.a == "b"`,
+		`'a' + 'b'`:            `"a" + "b"`,
+		`'say "hi"'`:           `"say \"hi\""`,
+		`"it's" + 'x'`:         `"it's" + "x"`,
+		`"escaped \" 'quote'"`: `"escaped \" 'quote'"`,
+		`.a == 'b`:             `.a == 'b`,
+		`.a`:                   `.a`,
+	}
+	for expression, expected := range tests {
+		if normalized := normalizeSingleQuotedStrings(expression); normalized != expected {
+			t.Errorf("expected %s to be normalized to %s, got %s", expression, expected, normalized)
+		}
+	}
+}
+
+func TestJqValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       types.String
+		expectError bool
+	}{
+		{name: "valid", value: types.StringValue(".body.id")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "invalid", value: types.StringValue(".body |"), expectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("filter"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+			Jq().ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Fatalf("expected an error: %t, got %v", tt.expectError, resp.Diagnostics)
+			}
+			if tt.expectError && !resp.Diagnostics[0].(diag.DiagnosticWithPath).Path().Equal(path.Root("filter")) {
+				t.Error("expected the error to be reported on the attribute")
+			}
+		})
+	}
+}
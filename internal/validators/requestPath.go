@@ -0,0 +1,36 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+var _ validator.String = requestPathValidator{}
+
+type requestPathValidator struct{}
+
+// RequestPath returns a validator which ensures that any configured string value is a path relative to the Port API
+// URL, without a scheme or a host. Null (unconfigured) and unknown (known after apply) values are skipped.
+func RequestPath() validator.String {
+	return requestPathValidator{}
+}
+
+func (v requestPathValidator) Description(ctx context.Context) string {
+	return "value must be a path relative to the Port API URL"
+}
+
+func (v requestPathValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v requestPathValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := cli.ValidateRequestPath(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid request path", err.Error())
+	}
+}
@@ -0,0 +1,37 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRequestPathValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       types.String
+		expectError bool
+	}{
+		{name: "path", value: types.StringValue("v1/blueprints/microservice")},
+		{name: "leading slash", value: types.StringValue("/v1/blueprints")},
+		{name: "identifier with a colon", value: types.StringValue("v1/webhooks/a:b")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "absolute URL", value: types.StringValue("https://example.com/v1/blueprints"), expectError: true},
+		{name: "scheme relative URL", value: types.StringValue("//example.com/v1/blueprints"), expectError: true},
+		{name: "scheme only", value: types.StringValue("http:v1/blueprints"), expectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("path"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+			RequestPath().ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Fatalf("expected an error: %t, got %v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
 	"github.com/port-labs/terraform-provider-port-labs/v2/provider"
 )
 
@@ -19,7 +20,7 @@ func main() {
 		context.Background(),
 		provider.New,
 		providerserver.ServeOpts{
-			Address: "registry.terraform.io/port-labs/port-labs",
+			Address: consts.ProviderAddress,
 			Debug:   debug,
 		},
 	)
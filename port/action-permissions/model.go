@@ -1,20 +1,23 @@
 package action_permissions
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+)
 
 type ExecuteModel struct {
-	Users       []types.String `tfsdk:"users"`
-	Roles       []types.String `tfsdk:"roles"`
-	Teams       []types.String `tfsdk:"teams"`
-	OwnedByTeam types.Bool     `tfsdk:"owned_by_team"`
-	Policy      types.String   `tfsdk:"policy"`
+	Users       []types.String       `tfsdk:"users"`
+	Roles       []types.String       `tfsdk:"roles"`
+	Teams       []types.String       `tfsdk:"teams"`
+	OwnedByTeam types.Bool           `tfsdk:"owned_by_team"`
+	Policy      jsontypes.Normalized `tfsdk:"policy"`
 }
 
 type ApproveModel struct {
-	Users  []types.String `tfsdk:"users"`
-	Roles  []types.String `tfsdk:"roles"`
-	Teams  []types.String `tfsdk:"teams"`
-	Policy types.String   `tfsdk:"policy"`
+	Users  []types.String       `tfsdk:"users"`
+	Roles  []types.String       `tfsdk:"roles"`
+	Teams  []types.String       `tfsdk:"teams"`
+	Policy jsontypes.Normalized `tfsdk:"policy"`
 }
 
 type PermissionsModel struct {
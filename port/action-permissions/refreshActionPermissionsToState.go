@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 )
 
 func refreshActionPermissionsState(state *ActionPermissionsModel, a *cli.ActionPermissions, actionId string) error {
@@ -38,7 +39,7 @@ func refreshActionPermissionsState(state *ActionPermissionsModel, a *cli.ActionP
 			return err
 		}
 
-		state.Permissions.Execute.Policy = types.StringValue(string(policy))
+		state.Permissions.Execute.Policy = jsontypes.NewNormalizedValue(string(policy))
 	}
 
 	state.Permissions.Approve = &ApproveModel{}
@@ -64,7 +65,7 @@ func refreshActionPermissionsState(state *ActionPermissionsModel, a *cli.ActionP
 			return err
 		}
 
-		state.Permissions.Approve.Policy = types.StringValue(string(policy))
+		state.Permissions.Approve.Policy = jsontypes.NewNormalizedValue(string(policy))
 	}
 
 	return nil
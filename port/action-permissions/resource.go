@@ -3,10 +3,10 @@ package action_permissions
 import (
 	"context"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
 )
 
 var _ resource.Resource = &ActionPermissionsResource{}
@@ -33,7 +33,7 @@ func (r *ActionPermissionsResource) Configure(ctx context.Context, req resource.
 }
 
 func (r *ActionPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("action_identifier"), req.ID)...)
+	importid.Import(ctx, req, resp, "action_identifier")
 }
 
 func (r *ActionPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
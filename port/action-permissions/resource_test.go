@@ -413,6 +413,48 @@ func TestAccPortActionPermissionsWithPolicyUpdate(t *testing.T) {
 	})
 }
 
+func TestAccPortActionPermissionsWithPolicyKeysOrder(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	// the policy keys aren't sorted and the policy has extra whitespace, Port returns it sorted and compact
+	policy := `{ "queries": { "executingUser": { "rules": [ { "value": "user", "property": "$blueprint", "operator": "=" } ], "combinator": "and" } }, "conditions": [ "true" ] }`
+	var testAccActionPermissionsConfig = testAccCreateBlueprintAndActionConfig(blueprintIdentifier, actionIdentifier) + fmt.Sprintf(`
+	resource "port_action_permissions" "create_microservice_permissions" {
+	  action_identifier = port_action.create_microservice.identifier
+	  permissions = {
+		"execute": {
+		  "roles": [],
+		  "users": [],
+		  "teams": [],
+		  "owned_by_team": false
+		},
+		"approve": {
+		  "roles": [],
+		  "users": [],
+		  "teams": [],
+		  "policy": %q
+		}
+	  }
+	}`, policy)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActionPermissionsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action_permissions.create_microservice_permissions", "permissions.approve.policy", policy),
+				),
+			},
+			{
+				Config:   testAccActionPermissionsConfig,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccPortActionPermissionsImportState(t *testing.T) {
 	blueprintIdentifier := utils.GenID()
 	actionIdentifier := utils.GenID()
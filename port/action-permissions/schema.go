@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 )
 
 func ActionPermissionsSchema() map[string]schema.Attribute {
@@ -65,6 +66,7 @@ func ActionPermissionsSchema() map[string]schema.Attribute {
 						"policy": schema.StringAttribute{
 							MarkdownDescription: "The policy to use for execution",
 							Optional:            true,
+							CustomType:          jsontypes.NormalizedType{},
 						},
 					},
 				},
@@ -96,6 +98,7 @@ func ActionPermissionsSchema() map[string]schema.Attribute {
 						"policy": schema.StringAttribute{
 							MarkdownDescription: "The policy to use for approval",
 							Optional:            true,
+							CustomType:          jsontypes.NormalizedType{},
 						},
 					},
 				},
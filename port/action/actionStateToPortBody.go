@@ -3,6 +3,7 @@ package action
 import (
 	"context"
 	"reflect"
+	"sort"
 
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
@@ -243,6 +244,8 @@ func actionPropertiesToBody(ctx context.Context, actionTrigger *cli.Trigger, dat
 		}
 		actionTrigger.UserInputs.Required = RequiredJqQueryMap
 	} else {
+		// the user properties are maps, sorting keeps the body the same between plans
+		sort.Strings(required)
 		actionTrigger.UserInputs.Required = required
 	}
 
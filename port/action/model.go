@@ -3,6 +3,7 @@ package action
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 )
 
 type Value struct {
@@ -241,17 +242,17 @@ type ObjectPropModel struct {
 	Visible        types.Bool   `tfsdk:"visible"`
 	VisibleJqQuery types.String `tfsdk:"visible_jq_query"`
 
-	Default    types.String `tfsdk:"default"`
-	Encryption types.String `tfsdk:"encryption"`
+	Default    jsontypes.Normalized `tfsdk:"default"`
+	Encryption types.String         `tfsdk:"encryption"`
 }
 
 type StringItems struct {
-	Blueprint   types.String `tfsdk:"blueprint"`
-	Format      types.String `tfsdk:"format"`
-	Default     types.List   `tfsdk:"default"`
-	Enum        types.List   `tfsdk:"enum"`
-	EnumJqQuery types.String `tfsdk:"enum_jq_query"`
-	Dataset     types.String `tfsdk:"dataset"`
+	Blueprint   types.String         `tfsdk:"blueprint"`
+	Format      types.String         `tfsdk:"format"`
+	Default     types.List           `tfsdk:"default"`
+	Enum        types.List           `tfsdk:"enum"`
+	EnumJqQuery types.String         `tfsdk:"enum_jq_query"`
+	Dataset     jsontypes.Normalized `tfsdk:"dataset"`
 }
 
 type NumberItems struct {
@@ -288,7 +289,7 @@ type SelfServiceTriggerModel struct {
 	RequiredJqQuery     types.String         `tfsdk:"required_jq_query"`
 	OrderProperties     types.List           `tfsdk:"order_properties"`
 	Steps               []Step               `tfsdk:"steps"`
-	Condition           types.String         `tfsdk:"condition"`
+	Condition           jsontypes.Normalized `tfsdk:"condition"`
 }
 
 type EntityCreatedEventModel struct {
@@ -342,45 +343,45 @@ type AutomationTriggerModel struct {
 }
 
 type KafkaMethodModel struct {
-	Payload types.String `tfsdk:"payload"`
+	Payload jsontypes.Normalized `tfsdk:"payload"`
 }
 
 type WebhookMethodModel struct {
-	Url          types.String `tfsdk:"url"`
-	Agent        types.String `tfsdk:"agent"`
-	Synchronized types.String `tfsdk:"synchronized"`
-	Method       types.String `tfsdk:"method"`
-	Headers      types.Map    `tfsdk:"headers"`
-	Body         types.String `tfsdk:"body"`
+	Url          types.String         `tfsdk:"url"`
+	Agent        types.String         `tfsdk:"agent"`
+	Synchronized types.String         `tfsdk:"synchronized"`
+	Method       types.String         `tfsdk:"method"`
+	Headers      types.Map            `tfsdk:"headers"`
+	Body         jsontypes.Normalized `tfsdk:"body"`
 }
 
 type GithubMethodModel struct {
-	Org                  types.String `tfsdk:"org"`
-	Repo                 types.String `tfsdk:"repo"`
-	Workflow             types.String `tfsdk:"workflow"`
-	WorkflowInputs       types.String `tfsdk:"workflow_inputs"`
-	ReportWorkflowStatus types.String `tfsdk:"report_workflow_status"`
+	Org                  types.String         `tfsdk:"org"`
+	Repo                 types.String         `tfsdk:"repo"`
+	Workflow             types.String         `tfsdk:"workflow"`
+	WorkflowInputs       jsontypes.Normalized `tfsdk:"workflow_inputs"`
+	ReportWorkflowStatus types.String         `tfsdk:"report_workflow_status"`
 }
 
 type GitlabMethodModel struct {
-	ProjectName       types.String `tfsdk:"project_name"`
-	GroupName         types.String `tfsdk:"group_name"`
-	DefaultRef        types.String `tfsdk:"default_ref"`
-	PipelineVariables types.String `tfsdk:"pipeline_variables"`
+	ProjectName       types.String         `tfsdk:"project_name"`
+	GroupName         types.String         `tfsdk:"group_name"`
+	DefaultRef        types.String         `tfsdk:"default_ref"`
+	PipelineVariables jsontypes.Normalized `tfsdk:"pipeline_variables"`
 }
 
 type AzureMethodModel struct {
-	Org     types.String `tfsdk:"org"`
-	Webhook types.String `tfsdk:"webhook"`
-	Payload types.String `tfsdk:"payload"`
+	Org     types.String         `tfsdk:"org"`
+	Webhook types.String         `tfsdk:"webhook"`
+	Payload jsontypes.Normalized `tfsdk:"payload"`
 }
 
 type MappingModel struct {
-	Properties types.String   `tfsdk:"properties"`
-	Relations  types.String   `tfsdk:"relations"`
-	Identifier types.String   `tfsdk:"identifier"`
-	Teams      []types.String `tfsdk:"teams"`
-	Icon       types.String   `tfsdk:"icon"`
+	Properties jsontypes.Normalized `tfsdk:"properties"`
+	Relations  jsontypes.Normalized `tfsdk:"relations"`
+	Identifier types.String         `tfsdk:"identifier"`
+	Teams      []types.String       `tfsdk:"teams"`
+	Icon       types.String         `tfsdk:"icon"`
 }
 
 type UpsertEntityMethodModel struct {
@@ -0,0 +1,20 @@
+package action
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/stateupgrade"
+)
+
+// legacyTypeName is the type of the actions of the 0.x providers, their states are in the flat trigger layout
+// upgradeStateV0 upgrades
+const legacyTypeName = "port-labs_action"
+
+func (r *ActionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		stateupgrade.Mover(legacyTypeName, func(source stateupgrade.State, sourceSchemaVersion int64) (stateupgrade.State, error) {
+			return source, upgradeStateV0(source)
+		}),
+	}
+}
@@ -0,0 +1,12 @@
+package action_test
+
+import (
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/action"
+)
+
+func TestMoveStateFromLegacyAction(t *testing.T) {
+	acctest.CheckStateMove(t, &action.ActionResource{}, "port-labs_action", 0, "testdata/state_v0_flat_trigger.json", "testdata/state_v1_flat_trigger.json")
+}
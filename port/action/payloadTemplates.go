@@ -0,0 +1,166 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/validators"
+)
+
+var templateExpressionRegex = regexp.MustCompile(`(?s){{(.*?)}}`)
+var inputReferenceRegex = regexp.MustCompile(`\.inputs(?:\.([A-Za-z0-9_-]+)|\[\s*"([^"]+)"\s*\])`)
+var secretReferenceRegex = regexp.MustCompile(`\.secrets(?:\.([A-Za-z0-9_-]+)|\[\s*"([^"]+)"\s*\])`)
+
+// payloadTemplatePaths are the invocation method attributes that hold JSON strings with {{ }} template expressions
+var payloadTemplatePaths = []path.Path{
+	path.Root("kafka_method").AtName("payload"),
+	path.Root("webhook_method").AtName("body"),
+	path.Root("github_method").AtName("workflow_inputs"),
+	path.Root("gitlab_method").AtName("pipeline_variables"),
+	path.Root("azure_method").AtName("payload"),
+}
+
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+type payloadTemplate struct {
+	Path  path.Path
+	Value string
+	// JSON is set for templates that are JSON encoded, their expressions are looked up in the decoded strings
+	JSON bool
+}
+
+type payloadTemplateReference struct {
+	Identifier string
+	Path       path.Path
+}
+
+// payloadTemplates returns the known values of the invocation method attributes that hold template expressions
+func payloadTemplates(ctx context.Context, data attributeGetter) ([]payloadTemplate, diag.Diagnostics) {
+	var templates []payloadTemplate
+	var diags diag.Diagnostics
+
+	for _, p := range payloadTemplatePaths {
+		var value jsontypes.Normalized
+		diags.Append(data.GetAttribute(ctx, p, &value)...)
+		if !value.IsNull() && !value.IsUnknown() {
+			templates = append(templates, payloadTemplate{Path: p, Value: value.ValueString(), JSON: true})
+		}
+	}
+
+	headersPath := path.Root("webhook_method").AtName("headers")
+	var headers types.Map
+	diags.Append(data.GetAttribute(ctx, headersPath, &headers)...)
+	if !headers.IsNull() && !headers.IsUnknown() {
+		elements := headers.Elements()
+		for _, key := range sortedKeys(elements) {
+			header, ok := elements[key].(types.String)
+			if ok && !header.IsNull() && !header.IsUnknown() {
+				templates = append(templates, payloadTemplate{Path: headersPath.AtMapKey(key), Value: header.ValueString()})
+			}
+		}
+	}
+
+	return templates, diags
+}
+
+// templateExpressions returns the jq expressions inside the {{ }} of the template
+func templateExpressions(template payloadTemplate) []string {
+	var expressions []string
+	for _, s := range templateStrings(template) {
+		for _, match := range templateExpressionRegex.FindAllStringSubmatch(s, -1) {
+			expressions = append(expressions, strings.TrimSpace(match[1]))
+		}
+	}
+	return expressions
+}
+
+// templateStrings returns the strings of a JSON encoded template, quotes inside expressions are escaped in the encoded
+// form. Templates that aren't valid JSON are returned as is
+func templateStrings(template payloadTemplate) []string {
+	var decoded any
+	if !template.JSON || json.Unmarshal([]byte(template.Value), &decoded) != nil {
+		return []string{template.Value}
+	}
+
+	var strs []string
+	var collect func(v any)
+	collect = func(v any) {
+		switch value := v.(type) {
+		case string:
+			strs = append(strs, value)
+		case []any:
+			for _, item := range value {
+				collect(item)
+			}
+		case map[string]any:
+			for _, key := range sortedKeys(value) {
+				strs = append(strs, key)
+				collect(value[key])
+			}
+		}
+	}
+	collect(decoded)
+	return strs
+}
+
+func expressionReferences(expression string, referenceRegex *regexp.Regexp) []string {
+	var references []string
+	for _, match := range referenceRegex.FindAllStringSubmatch(expression, -1) {
+		if match[1] != "" {
+			references = append(references, match[1])
+		} else {
+			references = append(references, match[2])
+		}
+	}
+	return references
+}
+
+// templatesReferences returns the identifiers the templates reference with the given regex, e.g. .inputs.x or .secrets["y"]
+func templatesReferences(templates []payloadTemplate, referenceRegex *regexp.Regexp) []payloadTemplateReference {
+	var references []payloadTemplateReference
+	for _, template := range templates {
+		for _, expression := range templateExpressions(template) {
+			for _, identifier := range expressionReferences(expression, referenceRegex) {
+				references = append(references, payloadTemplateReference{Identifier: identifier, Path: template.Path})
+			}
+		}
+	}
+	return references
+}
+
+// validatePayloadTemplates checks that the template expressions are valid jq and, when the user properties are
+// known, that every .inputs reference names a declared user property
+func validatePayloadTemplates(ctx context.Context, data attributeGetter, declared map[string][]userPropertyReference, declaredKnown bool) diag.Diagnostics {
+	templates, diags := payloadTemplates(ctx, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, template := range templates {
+		for _, expression := range templateExpressions(template) {
+			if err := validators.ValidateJq(expression); err != nil {
+				diags.AddAttributeError(template.Path, "Invalid payload template", err.Error())
+			}
+		}
+	}
+
+	if !declaredKnown {
+		return diags
+	}
+	for _, reference := range templatesReferences(templates, inputReferenceRegex) {
+		if _, ok := declared[reference.Identifier]; !ok {
+			diags.AddAttributeError(reference.Path, "Invalid payload template", fmt.Sprintf(".inputs.%s isn't a user property of the action, available user properties are: %s", reference.Identifier, strings.Join(sortedKeys(declared), ", ")))
+		}
+	}
+
+	return diags
+}
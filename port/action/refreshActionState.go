@@ -14,12 +14,13 @@ import (
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 func writeInvocationMethodToResource(ctx context.Context, a *cli.Action, state *ActionModel) error {
 	if a.InvocationMethod.Type == consts.Kafka {
-		payload, err := utils.GoObjectToTerraformString(a.InvocationMethod.Payload)
+		payload, err := utils.GoObjectToTerraformJson(a.InvocationMethod.Payload)
 		if err != nil {
 			return err
 		}
@@ -39,7 +40,7 @@ func writeInvocationMethodToResource(ctx context.Context, a *cli.Action, state *
 			return err
 		}
 		headers, _ := types.MapValueFrom(ctx, types.StringType, a.InvocationMethod.Headers)
-		body, err := utils.GoObjectToTerraformString(a.InvocationMethod.Body)
+		body, err := utils.GoObjectToTerraformJson(a.InvocationMethod.Body)
 		if err != nil {
 			return err
 		}
@@ -55,7 +56,7 @@ func writeInvocationMethodToResource(ctx context.Context, a *cli.Action, state *
 	}
 
 	if a.InvocationMethod.Type == consts.Github {
-		workflowInputs, err := utils.GoObjectToTerraformString(a.InvocationMethod.WorkflowInputs)
+		workflowInputs, err := utils.GoObjectToTerraformJson(a.InvocationMethod.WorkflowInputs)
 		if err != nil {
 			return err
 		}
@@ -74,7 +75,7 @@ func writeInvocationMethodToResource(ctx context.Context, a *cli.Action, state *
 	}
 
 	if a.InvocationMethod.Type == consts.Gitlab {
-		pipelineVariables, err := utils.GoObjectToTerraformString(a.InvocationMethod.PipelineVariables)
+		pipelineVariables, err := utils.GoObjectToTerraformJson(a.InvocationMethod.PipelineVariables)
 		if err != nil {
 			return err
 		}
@@ -88,7 +89,7 @@ func writeInvocationMethodToResource(ctx context.Context, a *cli.Action, state *
 	}
 
 	if a.InvocationMethod.Type == consts.AzureDevops {
-		payload, err := utils.GoObjectToTerraformString(a.InvocationMethod.Payload)
+		payload, err := utils.GoObjectToTerraformJson(a.InvocationMethod.Payload)
 		if err != nil {
 			return err
 		}
@@ -111,11 +112,11 @@ func writeInvocationMethodToResource(ctx context.Context, a *cli.Action, state *
 				teams = append(teams, types.StringValue(t.(string)))
 			}
 		}
-		properties, err := utils.GoObjectToTerraformString(a.InvocationMethod.Mapping.Properties)
+		properties, err := utils.GoObjectToTerraformJson(a.InvocationMethod.Mapping.Properties)
 		if err != nil {
 			return err
 		}
-		relations, err := utils.GoObjectToTerraformString(a.InvocationMethod.Mapping.Relations)
+		relations, err := utils.GoObjectToTerraformJson(a.InvocationMethod.Mapping.Relations)
 		if err != nil {
 			return err
 		}
@@ -355,7 +356,7 @@ func writeTriggerToResource(ctx context.Context, a *cli.Action, state *ActionMod
 			if err != nil {
 				return err
 			}
-			state.SelfServiceTrigger.Condition = types.StringValue(string(triggerCondition))
+			state.SelfServiceTrigger.Condition = jsontypes.NewNormalizedValue(string(triggerCondition))
 		}
 	}
 
@@ -560,12 +561,12 @@ func setCommonProperties(ctx context.Context, v cli.ActionProperty, prop interfa
 					if v["jqQuery"] != nil {
 						p.DefaultJqQuery = types.StringValue(v["jqQuery"].(string))
 					} else {
-						defaultValue, err := utils.GoObjectToTerraformString(v)
+						defaultValue, err := utils.GoObjectToTerraformJson(v)
 						if err != nil {
 							return fmt.Errorf("error converting default value to terraform string: %s", err.Error())
 						}
 						if defaultValue.IsNull() {
-							p.Default = types.StringNull()
+							p.Default = jsontypes.NewNormalizedNull()
 							p.DefaultJqQuery = types.StringNull()
 						}
 						p.Default = defaultValue
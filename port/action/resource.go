@@ -4,14 +4,19 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
+	"github.com/samber/lo"
 )
 
 var _ resource.Resource = &ActionResource{}
 var _ resource.ResourceWithImportState = &ActionResource{}
+var _ resource.ResourceWithModifyPlan = &ActionResource{}
+var _ resource.ResourceWithUpgradeState = &ActionResource{}
+var _ resource.ResourceWithMoveState = &ActionResource{}
 
 func NewActionResource() resource.Resource {
 	return &ActionResource{}
@@ -33,8 +38,42 @@ func (r *ActionResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.portClient = req.ProviderData.(*cli.PortClient)
 }
 
+func (r *ActionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// the secrets are only checked when the provider is configured to, as listing them needs more permissions and
+	// sends a request on every plan of an action
+	if req.Plan.Raw.IsNull() || r.portClient == nil || !r.portClient.ValidateSecretReferences {
+		return
+	}
+
+	templates, diags := payloadTemplates(ctx, req.Plan)
+	if diags.HasError() {
+		return
+	}
+	references := templatesReferences(templates, secretReferenceRegex)
+	if len(references) == 0 {
+		return
+	}
+
+	secrets, _, err := r.portClient.ReadOrganizationSecrets(ctx)
+	if err != nil {
+		// the secrets check is best effort, the credentials might not be allowed to list the organization secrets
+		tflog.Warn(ctx, "failed to read organization secrets, skipping secrets validation", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	secretNames := lo.Map(secrets, func(secret cli.OrganizationSecret, _ int) string {
+		return secret.SecretName
+	})
+	for _, reference := range references {
+		if !lo.Contains(secretNames, reference.Identifier) {
+			resp.Diagnostics.AddAttributeError(reference.Path, "Invalid payload template", fmt.Sprintf(".secrets.%s doesn't exist in the organization", reference.Identifier))
+		}
+	}
+}
+
 func (r *ActionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), req.ID)...)
+	importid.Import(ctx, req, resp, "identifier")
 }
 
 func (r *ActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -3,6 +3,7 @@ package action_test
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -2477,3 +2478,211 @@ func TestAccPortActionStepsConflictWithOrder(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPortActionInvalidJqQuery(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title             = "Action 1"
+		identifier        = "%s"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+			user_properties = {
+				string_props = {
+					myStringIdentifier = {
+						title      = "myStringIdentifier"
+						default_jq_query = ".entity.properties | .title |"
+					}
+				}
+			}
+		}
+		kafka_method = {}
+	}`, actionIdentifier)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccActionConfigCreate,
+				ExpectError: regexp.MustCompile(`(?s)Invalid jq expression.*"\.entity\.properties`),
+			},
+		},
+	})
+}
+
+func TestAccPortAutomationInvalidJqCondition(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		automation_trigger = {
+			entity_created_event = {
+				blueprint_identifier = port_blueprint.microservice.identifier
+			}
+			jq_condition = {
+				expressions = [".diff.after.title == \"test\"", ".diff.after.properties[\"text\""]
+			}
+		}
+		kafka_method = {}
+	}`, actionIdentifier)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccActionConfigCreate,
+				ExpectError: regexp.MustCompile(`(?s)Invalid jq expression.*properties\[\\"text\\""`),
+			},
+		},
+	})
+}
+
+func TestAccPortActionInvalidUserPropertiesReferences(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfig = func(references string) string {
+		return testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+	  title      = "TF Provider Test"
+	  identifier = "%s"
+	  icon       = "Terraform"
+	  self_service_trigger = {
+	    operation            = "CREATE"
+	    user_properties = {
+	      "string_props" = {
+	        "prop1" = {
+	          "title" = "Property #1"
+	          "depends_on" = ["prop2"]
+	        }
+	        "prop2" = {
+	          "title" = "Property #2"
+	        }
+	      }
+	    }
+	    %s
+	  }
+	  kafka_method = {}
+	}`, actionIdentifier, references)
+	}
+
+	var testAccDependsOnCycleConfig = strings.Replace(testAccActionConfig(""), `"title" = "Property #2"`, `"title" = "Property #2"
+	          "depends_on" = ["prop1"]`, 1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccActionConfig(`order_properties = ["prop2", "prop3"]`),
+				ExpectError: regexp.MustCompile(`prop3 isn't a user property of the action`),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccActionConfig(`steps = [
+	      {
+	        title = "Step #1"
+	        order = ["prop2", "prop1"]
+	      },
+	      {
+	        title = "Step #2"
+	        order = ["prop1"]
+	      }
+	    ]`),
+				ExpectError: regexp.MustCompile(`user property prop1 is already ordered in steps\[0\]`),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccActionConfig(`steps = [
+	      {
+	        title = "Step #1"
+	        order = ["prop2"]
+	      }
+	    ]`),
+				ExpectError: regexp.MustCompile(`user property prop1 isn't ordered in any step`),
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccDependsOnCycleConfig,
+				ExpectError: regexp.MustCompile(`depends_on has a cycle: prop1 -> prop2 -> prop1`),
+			},
+		},
+	})
+}
+
+func TestAccPortActionInvalidPayloadTemplate(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfig = func(payload string) string {
+		return testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+	  title      = "TF Provider Test"
+	  identifier = "%s"
+	  icon       = "Terraform"
+	  self_service_trigger = {
+	    operation            = "CREATE"
+	    user_properties = {
+	      "string_props" = {
+	        "name" = {
+	          "title" = "Name"
+	        }
+	      }
+	    }
+	  }
+	  kafka_method = {
+	    payload = jsonencode(%s)
+	  }
+	}`, actionIdentifier, payload)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccActionConfig(`{"name": "{{ .inputs.name }}", "owner": "{{ .inputs[\"owner\"] }}"}`),
+				ExpectError: regexp.MustCompile(`(?s)\.inputs\.owner isn't a user property of the action.*available user.*properties are: name`),
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccActionConfig(`{"name": "{{ .inputs.name | }}"}`),
+				ExpectError: regexp.MustCompile(`Invalid payload template`),
+			},
+		},
+	})
+}
+
+func TestAccPortActionSecretReferences(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	secretName := utils.GenID()
+	var testAccActionConfig = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+	  title      = "TF Provider Test"
+	  identifier = "%s"
+	  icon       = "Terraform"
+	  self_service_trigger = {
+	    operation = "CREATE"
+	  }
+	  kafka_method = {
+	    payload = jsonencode({"token": "{{ .secrets[\"%s\"] }}"})
+	  }
+	}`, actionIdentifier, secretName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// the secrets are only checked when the provider is configured to
+				Config:             acctest.ProviderConfig + testAccActionConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      acctest.ProviderConfigValidateSecretReferences + testAccActionConfig,
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`(?s)\.secrets\.%s doesn't.*exist in the organization`, secretName)),
+			},
+		},
+	})
+}
@@ -22,7 +22,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/validators"
 )
 
 func MetadataProperties() map[string]schema.Attribute {
@@ -54,6 +56,34 @@ func MetadataProperties() map[string]schema.Attribute {
 	}
 }
 
+func userPropertiesAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"string_props":  StringPropertySchema(),
+		"number_props":  NumberPropertySchema(),
+		"boolean_props": BooleanPropertySchema(),
+		"object_props":  ObjectPropertySchema(),
+		"array_props":   ArrayPropertySchema(),
+	}
+}
+
+// emptyObject returns an object of the attributes whose attributes are all null, object defaults must have the type
+// of their attribute
+func emptyObject(attributes map[string]schema.Attribute) types.Object {
+	ctx := context.Background()
+	attributeTypes := make(map[string]attr.Type, len(attributes))
+	values := make(map[string]attr.Value, len(attributes))
+	for name, attribute := range attributes {
+		attributeType := attribute.GetType()
+		value, err := attributeType.ValueFromTerraform(ctx, tftypes.NewValue(attributeType.TerraformType(ctx), nil))
+		if err != nil {
+			panic(err)
+		}
+		attributeTypes[name] = attributeType
+		values[name] = value
+	}
+	return types.ObjectValueMust(attributeTypes, values)
+}
+
 func StringBooleanOrJQTemplateValidator() []validator.String {
 	return []validator.String{
 		stringvalidator.Any(
@@ -111,18 +141,15 @@ func ActionSchema() map[string]schema.Attribute {
 					MarkdownDescription: "User properties",
 					Optional:            true,
 					Computed:            true,
-					Default:             objectdefault.StaticValue(types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{})),
-					Attributes: map[string]schema.Attribute{
-						"string_props":  StringPropertySchema(),
-						"number_props":  NumberPropertySchema(),
-						"boolean_props": BooleanPropertySchema(),
-						"object_props":  ObjectPropertySchema(),
-						"array_props":   ArrayPropertySchema(),
-					},
+					Default:             objectdefault.StaticValue(emptyObject(userPropertiesAttributes())),
+					Attributes:          userPropertiesAttributes(),
 				},
 				"required_jq_query": schema.StringAttribute{
 					MarkdownDescription: "The required jq query of the property",
 					Optional:            true,
+					Validators: []validator.String{
+						validators.Jq(),
+					},
 				},
 				"order_properties": schema.ListAttribute{
 					MarkdownDescription: "Order properties",
@@ -154,6 +181,7 @@ func ActionSchema() map[string]schema.Attribute {
 				"condition": schema.StringAttribute{
 					MarkdownDescription: "The `condition` field allows you to define rules using Port's [search & query syntax](https://docs.getport.io/search-and-query/#rules) to determine which entities the action will be available for.",
 					Optional:            true,
+					CustomType:          jsontypes.NormalizedType{},
 				},
 			},
 			Validators: []validator.Object{
@@ -271,6 +299,9 @@ func ActionSchema() map[string]schema.Attribute {
 							MarkdownDescription: "The jq expressions of the condition",
 							ElementType:         types.StringType,
 							Required:            true,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(validators.Jq()),
+							},
 						},
 						"combinator": schema.StringAttribute{
 							MarkdownDescription: "The combinator of the condition",
@@ -292,6 +323,7 @@ func ActionSchema() map[string]schema.Attribute {
 				"payload": schema.StringAttribute{
 					MarkdownDescription: "The Kafka message [payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload) should be in `JSON` format, encoded as a string. Use [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) to encode arrays or objects. Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload).",
 					Optional:            true,
+					CustomType:          jsontypes.NormalizedType{},
 				},
 			},
 			Validators: []validator.Object{
@@ -335,6 +367,7 @@ func ActionSchema() map[string]schema.Attribute {
 				"body": schema.StringAttribute{
 					MarkdownDescription: "The Webhook body should be in `JSON` format, encoded as a string. Use [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) to encode arrays or objects. Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload).",
 					Optional:            true,
+					CustomType:          jsontypes.NormalizedType{},
 				},
 			},
 		},
@@ -357,6 +390,7 @@ func ActionSchema() map[string]schema.Attribute {
 				"workflow_inputs": schema.StringAttribute{
 					MarkdownDescription: "The GitHub [workflow inputs](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload) should be in `JSON` format, encoded as a string. Use [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) to encode arrays or objects. Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload).",
 					Optional:            true,
+					CustomType:          jsontypes.NormalizedType{},
 				},
 				"report_workflow_status": schema.StringAttribute{
 					MarkdownDescription: "Report the workflow status when invoking the action",
@@ -384,6 +418,7 @@ func ActionSchema() map[string]schema.Attribute {
 				"pipeline_variables": schema.StringAttribute{
 					MarkdownDescription: "The Gitlab pipeline variables should be in `JSON` format, encoded as a string. Use [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) to encode arrays or objects. Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload).",
 					Optional:            true,
+					CustomType:          jsontypes.NormalizedType{},
 				},
 			},
 		},
@@ -402,6 +437,7 @@ func ActionSchema() map[string]schema.Attribute {
 				"payload": schema.StringAttribute{
 					MarkdownDescription: "The Azure Devops workflow [payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload) should be in `JSON` format, encoded as a string. Use [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) to encode arrays or objects. Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload).",
 					Optional:            true,
+					CustomType:          jsontypes.NormalizedType{},
 				},
 			},
 		},
@@ -437,10 +473,12 @@ func ActionSchema() map[string]schema.Attribute {
 						"properties": schema.StringAttribute{
 							MarkdownDescription: "The properties of the entity (key-value object encoded to a string)",
 							Optional:            true,
+							CustomType:          jsontypes.NormalizedType{},
 						},
 						"relations": schema.StringAttribute{
 							MarkdownDescription: "The relations of the entity (key-value object encoded to a string)",
 							Optional:            true,
+							CustomType:          jsontypes.NormalizedType{},
 						},
 					},
 				},
@@ -498,6 +536,7 @@ func StringPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("default")),
+				validators.Jq(),
 			},
 		},
 		"blueprint": schema.StringAttribute{
@@ -540,6 +579,7 @@ func StringPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("enum")),
+				validators.Jq(),
 			},
 		},
 		"encryption": schema.StringAttribute{
@@ -558,6 +598,7 @@ func StringPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("visible")),
+				validators.Jq(),
 			},
 		},
 		"dataset": schema.SingleNestedAttribute{
@@ -642,6 +683,7 @@ func NumberPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("default")),
+				validators.Jq(),
 			},
 		},
 		"maximum": schema.Float64Attribute{
@@ -666,6 +708,7 @@ func NumberPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("enum")),
+				validators.Jq(),
 			},
 		},
 		"visible": schema.BoolAttribute{
@@ -677,6 +720,7 @@ func NumberPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("visible")),
+				validators.Jq(),
 			},
 		},
 	}
@@ -702,6 +746,7 @@ func BooleanPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("default")),
+				validators.Jq(),
 			},
 		},
 		"visible": schema.BoolAttribute{
@@ -713,6 +758,7 @@ func BooleanPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("visible")),
+				validators.Jq(),
 			},
 		},
 	}
@@ -732,12 +778,14 @@ func ObjectPropertySchema() schema.Attribute {
 		"default": schema.StringAttribute{
 			Optional:            true,
 			MarkdownDescription: "The default of the object property",
+			CustomType:          jsontypes.NormalizedType{},
 		},
 		"default_jq_query": schema.StringAttribute{
 			Optional:            true,
 			MarkdownDescription: "The default jq query of the object property",
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("default")),
+				validators.Jq(),
 			},
 		},
 		"encryption": schema.StringAttribute{
@@ -756,6 +804,7 @@ func ObjectPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("visible")),
+				validators.Jq(),
 			},
 		},
 	}
@@ -790,6 +839,7 @@ func ArrayPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("string_items").AtName("default")),
+				validators.Jq(),
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("number_items").AtName("default")),
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("boolean_items").AtName("default")),
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("object_items").AtName("default")),
@@ -826,11 +876,13 @@ func ArrayPropertySchema() schema.Attribute {
 					Optional:            true,
 					Validators: []validator.String{
 						stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("enum")),
+						validators.Jq(),
 					},
 				},
 				"dataset": schema.StringAttribute{
 					MarkdownDescription: "The dataset of the entity-format items",
 					Optional:            true,
+					CustomType:          jsontypes.NormalizedType{},
 				},
 			},
 		},
@@ -857,6 +909,7 @@ func ArrayPropertySchema() schema.Attribute {
 					Optional:            true,
 					Validators: []validator.String{
 						stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("enum")),
+						validators.Jq(),
 					},
 				},
 			},
@@ -892,6 +945,7 @@ func ArrayPropertySchema() schema.Attribute {
 			Optional:            true,
 			Validators: []validator.String{
 				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("visible")),
+				validators.Jq(),
 			},
 		},
 		"sort": schema.SingleNestedAttribute{
@@ -928,6 +982,7 @@ func ArrayPropertySchema() schema.Attribute {
 func (r *ActionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: ResourceMarkdownDescription,
+		Version:             schemaVersion,
 		Attributes:          ActionSchema(),
 	}
 }
@@ -942,6 +997,11 @@ func (r *ActionResource) ValidateConfig(ctx context.Context, req resource.Valida
 	}
 
 	validateUserInputRequiredNotSetToFalse(ctx, state, resp)
+	validateUserPropertiesReferences(ctx, req, resp)
+
+	declared, _, declaredKnown, diags := declaredUserProperties(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(validatePayloadTemplates(ctx, req.Config, declared, declaredKnown)...)
 }
 
 func validateUserInputRequiredNotSetToFalse(ctx context.Context, state *ActionValidationModel, resp *resource.ValidateConfigResponse) {
@@ -1273,4 +1333,15 @@ resource "port_action" "create_microservice" {
 	
 ` + "```" + `
 
+## Moving from the 0.x Provider
+
+Actions managed with the ` + "`port-labs_action`" + ` resource of the 0.x provider versions can be moved to ` + "`port_action`" + ` without recreating them, requires Terraform 1.8 or later. Their ` + "`blueprint`" + ` and ` + "`trigger`" + ` move to the ` + "`self_service_trigger`" + `:
+
+` + "```hcl" + `
+moved {
+  from = port-labs_action.deploy
+  to   = port_action.deploy
+}
+` + "```" + `
+
 `
@@ -0,0 +1,66 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/stateupgrade"
+)
+
+// schemaVersion is bumped, with an upgrader of the prior version, whenever a change of the schema breaks the states
+// stored by the prior versions of the provider
+const schemaVersion = 1
+
+func (r *ActionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: stateupgrade.Upgrader(upgradeStateV0),
+	}
+}
+
+// upgradeStateV0 upgrades the states stored before the schema was versioned. Those are either in the current layout,
+// or in the layout of the providers before the self service trigger, where the action was attached to a blueprint
+// and the trigger was flat:
+//
+//	blueprint         = "service"
+//	trigger           = "CREATE"
+//	user_properties   = { string_props = { ... } }
+//	required_approval = true
+//	webhook_method    = { url = "https://example.com", agent = true, synchronized = false }
+//	github_method     = { org = "port", repo = "infra", workflow = "deploy.yml", omit_payload = false, report_workflow_status = true }
+func upgradeStateV0(state stateupgrade.State) error {
+	if trigger, hasTrigger := state["trigger"]; hasTrigger || state["user_properties"] != nil {
+		operation, _ := trigger.(string)
+		if operation == "" {
+			return fmt.Errorf("the action %s has user properties without a trigger", stateupgrade.String(state, "identifier"))
+		}
+		selfServiceTrigger := map[string]any{
+			"operation":         operation,
+			"user_properties":   state["user_properties"],
+			"required_jq_query": state["required_jq_query"],
+			"order_properties":  state["order_properties"],
+		}
+		if blueprint := stateupgrade.String(state, "blueprint"); blueprint != "" {
+			selfServiceTrigger["blueprint_identifier"] = blueprint
+		}
+		stateupgrade.SetDefault(selfServiceTrigger, "user_properties", map[string]any{})
+		state["self_service_trigger"] = selfServiceTrigger
+		state["blueprint"] = nil
+	}
+
+	stateupgrade.BoolToString(state, "required_approval")
+	if webhookMethod := stateupgrade.Object(state, "webhook_method"); webhookMethod != nil {
+		stateupgrade.BoolToString(webhookMethod, "agent")
+		stateupgrade.BoolToString(webhookMethod, "synchronized")
+	}
+	if githubMethod := stateupgrade.Object(state, "github_method"); githubMethod != nil {
+		stateupgrade.BoolToString(githubMethod, "report_workflow_status")
+	}
+	if automationTrigger := stateupgrade.Object(state, "automation_trigger"); automationTrigger != nil {
+		if jqCondition := stateupgrade.Object(automationTrigger, "jq_condition"); jqCondition != nil {
+			stateupgrade.SetDefault(jqCondition, "combinator", "and")
+		}
+	}
+	stateupgrade.SetDefault(state, "publish", true)
+	return nil
+}
@@ -0,0 +1,20 @@
+package action_test
+
+import (
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/action"
+)
+
+func TestUpgradeStateV0FlatTrigger(t *testing.T) {
+	acctest.CheckStateUpgrade(t, &action.ActionResource{}, 0, "testdata/state_v0_flat_trigger.json", "testdata/state_v1_flat_trigger.json")
+}
+
+func TestUpgradeStateV0GithubMethod(t *testing.T) {
+	acctest.CheckStateUpgrade(t, &action.ActionResource{}, 0, "testdata/state_v0_github_method.json", "testdata/state_v1_github_method.json")
+}
+
+func TestUpgradeStateV0Current(t *testing.T) {
+	acctest.CheckStateUpgrade(t, &action.ActionResource{}, 0, "testdata/state_v0_current.json", "testdata/state_v0_current.json")
+}
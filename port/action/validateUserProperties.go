@@ -0,0 +1,200 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+var userPropertiesKinds = []string{"string_props", "number_props", "boolean_props", "object_props", "array_props"}
+
+type userPropertyReference struct {
+	Identifier string
+	Path       path.Path
+}
+
+// declaredUserProperties returns the user properties declared in the self service trigger, mapped to the references
+// in their depends_on, and the path of each depends_on. ok is false when the user properties aren't known yet
+func declaredUserProperties(ctx context.Context, config tfsdk.Config) (declared map[string][]userPropertyReference, dependsOnPaths map[string]path.Path, ok bool, diags diag.Diagnostics) {
+	sstPath := path.Root("self_service_trigger")
+	var sst types.Object
+	diags.Append(config.GetAttribute(ctx, sstPath, &sst)...)
+	if diags.HasError() || sst.IsNull() || sst.IsUnknown() {
+		return nil, nil, false, diags
+	}
+
+	userPropertiesPath := sstPath.AtName("user_properties")
+	userProperties, ok := sst.Attributes()["user_properties"].(types.Object)
+	if !ok || userProperties.IsUnknown() {
+		return nil, nil, false, diags
+	}
+
+	declared = make(map[string][]userPropertyReference)
+	dependsOnPaths = make(map[string]path.Path)
+	if userProperties.IsNull() {
+		return declared, dependsOnPaths, true, diags
+	}
+	for _, kind := range userPropertiesKinds {
+		props, ok := userProperties.Attributes()[kind].(types.Map)
+		if !ok || props.IsNull() {
+			continue
+		}
+		if props.IsUnknown() {
+			return nil, nil, false, diags
+		}
+		for identifier, prop := range props.Elements() {
+			propObject, ok := prop.(types.Object)
+			if !ok || propObject.IsUnknown() {
+				return nil, nil, false, diags
+			}
+			dependsOnPath := userPropertiesPath.AtName(kind).AtMapKey(identifier).AtName("depends_on")
+			dependsOnPaths[identifier] = dependsOnPath
+			dependsOn, _ := propObject.Attributes()["depends_on"].(types.List)
+			declared[identifier] = knownListReferences(dependsOn, dependsOnPath)
+		}
+	}
+	return declared, dependsOnPaths, true, diags
+}
+
+// validateUserPropertiesReferences checks that the identifiers referenced by order_properties, steps and depends_on
+// refer to declared user properties, that steps order each property exactly once and that depends_on has no cycles
+func validateUserPropertiesReferences(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	declared, dependsOnPaths, ok, diags := declaredUserProperties(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if !ok {
+		return
+	}
+
+	sstPath := path.Root("self_service_trigger")
+	var sst types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, sstPath, &sst)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateReferencesDeclared := func(references []userPropertyReference) {
+		for _, reference := range references {
+			if _, ok := declared[reference.Identifier]; !ok {
+				resp.Diagnostics.AddAttributeError(reference.Path, "Invalid user property reference", fmt.Sprintf("%s isn't a user property of the action, available user properties are: %s", reference.Identifier, strings.Join(sortedKeys(declared), ", ")))
+			}
+		}
+	}
+
+	orderProperties, _ := sst.Attributes()["order_properties"].(types.List)
+	validateReferencesDeclared(knownListReferences(orderProperties, sstPath.AtName("order_properties")))
+
+	for _, identifier := range sortedKeys(declared) {
+		validateReferencesDeclared(declared[identifier])
+	}
+
+	steps, _ := sst.Attributes()["steps"].(types.List)
+	if !steps.IsNull() && !steps.IsUnknown() {
+		validateStepsCoverUserProperties(steps, sstPath.AtName("steps"), declared, resp)
+	}
+
+	validateDependsOnCycles(declared, dependsOnPaths, resp)
+}
+
+func validateStepsCoverUserProperties(steps types.List, stepsPath path.Path, declared map[string][]userPropertyReference, resp *resource.ValidateConfigResponse) {
+	stepOfProperty := make(map[string]int)
+	allKnown := true
+	for i, step := range steps.Elements() {
+		stepObject, ok := step.(types.Object)
+		if !ok || stepObject.IsUnknown() {
+			allKnown = false
+			continue
+		}
+		order, _ := stepObject.Attributes()["order"].(types.List)
+		if order.IsUnknown() || lo.SomeBy(order.Elements(), func(v attr.Value) bool { return v.IsUnknown() }) {
+			allKnown = false
+		}
+		for _, reference := range knownListReferences(order, stepsPath.AtListIndex(i).AtName("order")) {
+			if _, ok := declared[reference.Identifier]; !ok {
+				resp.Diagnostics.AddAttributeError(reference.Path, "Invalid user property reference", fmt.Sprintf("%s isn't a user property of the action, available user properties are: %s", reference.Identifier, strings.Join(sortedKeys(declared), ", ")))
+				continue
+			}
+			if previousStep, ok := stepOfProperty[reference.Identifier]; ok {
+				resp.Diagnostics.AddAttributeError(reference.Path, "Invalid action steps", fmt.Sprintf("user property %s is already ordered in steps[%d], each user property must appear in exactly one step", reference.Identifier, previousStep))
+				continue
+			}
+			stepOfProperty[reference.Identifier] = i
+		}
+	}
+
+	// properties missing from the steps can only be detected when all the steps are known
+	if !allKnown {
+		return
+	}
+	for _, identifier := range sortedKeys(declared) {
+		if _, ok := stepOfProperty[identifier]; !ok {
+			resp.Diagnostics.AddAttributeError(stepsPath, "Invalid action steps", fmt.Sprintf("user property %s isn't ordered in any step, each user property must appear in exactly one step", identifier))
+		}
+	}
+}
+
+func validateDependsOnCycles(declared map[string][]userPropertyReference, dependsOnPaths map[string]path.Path, resp *resource.ValidateConfigResponse) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var stack []string
+
+	var visit func(identifier string)
+	visit = func(identifier string) {
+		state[identifier] = visiting
+		stack = append(stack, identifier)
+		for _, dependency := range declared[identifier] {
+			if _, ok := declared[dependency.Identifier]; !ok {
+				continue
+			}
+			switch state[dependency.Identifier] {
+			case unvisited:
+				visit(dependency.Identifier)
+			case visiting:
+				cycleStart := lo.IndexOf(stack, dependency.Identifier)
+				cycle := append(append([]string{}, stack[cycleStart:]...), dependency.Identifier)
+				resp.Diagnostics.AddAttributeError(dependsOnPaths[dependency.Identifier], "Invalid user property depends_on", fmt.Sprintf("depends_on has a cycle: %s", strings.Join(cycle, " -> ")))
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[identifier] = visited
+	}
+
+	for _, identifier := range sortedKeys(declared) {
+		if state[identifier] == unvisited {
+			visit(identifier)
+		}
+	}
+}
+
+func knownListReferences(list types.List, listPath path.Path) []userPropertyReference {
+	var references []userPropertyReference
+	if list.IsNull() || list.IsUnknown() {
+		return references
+	}
+	for i, element := range list.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		references = append(references, userPropertyReference{Identifier: value.ValueString(), Path: listPath.AtListIndex(i)})
+	}
+	return references
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := lo.Keys(m)
+	sort.Strings(keys)
+	return keys
+}
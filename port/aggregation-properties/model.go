@@ -1,6 +1,9 @@
 package aggregation_properties
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+)
 
 type AggregationPropertiesModel struct {
 	ID                  types.String                         `tfsdk:"id"`
@@ -14,7 +17,7 @@ type AggregationPropertyModel struct {
 	Description               types.String             `tfsdk:"description"`
 	TargetBlueprintIdentifier types.String             `tfsdk:"target_blueprint_identifier"`
 	Method                    *AggregationMethodsModel `tfsdk:"method"`
-	Query                     types.String             `tfsdk:"query"`
+	Query                     jsontypes.Normalized     `tfsdk:"query"`
 }
 
 type AggregationMethodsModel struct {
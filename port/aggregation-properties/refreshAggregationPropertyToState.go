@@ -3,6 +3,7 @@ package aggregation_properties
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
@@ -19,10 +20,10 @@ func refreshAggregationPropertiesState(state *AggregationPropertiesModel, aggreg
 			Description:               types.StringPointerValue(aggregationProperty.Description),
 			TargetBlueprintIdentifier: types.StringValue(aggregationProperty.Target),
 			Method:                    nil,
-			Query:                     types.StringPointerValue(nil),
+			Query:                     jsontypes.NewNormalizedNull(),
 		}
 
-		query, err := utils.GoObjectToTerraformString(aggregationProperty.Query)
+		query, err := utils.GoObjectToTerraformJson(aggregationProperty.Query)
 		if err != nil {
 			return err
 		}
@@ -5,10 +5,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
 )
 
 var _ resource.Resource = &AggregationPropertiesResource{}
 var _ resource.ResourceWithImportState = &AggregationPropertiesResource{}
+var _ resource.ResourceWithModifyPlan = &AggregationPropertiesResource{}
 
 func NewAggregationPropertiesResource() resource.Resource {
 	return &AggregationPropertiesResource{}
@@ -30,14 +32,17 @@ func (r *AggregationPropertiesResource) Configure(ctx context.Context, req resou
 	r.portClient = req.ProviderData.(*cli.PortClient)
 }
 
-func (r *AggregationPropertiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("blueprint_identifier"), req.ID,
-	)...)
+// ModifyPlan records the blueprint as changed, the plan validations of the resources that use it don't fail on the
+// parts of it that are only changed by the same apply
+func (r *AggregationPropertiesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.portClient == nil {
+		return
+	}
+	r.portClient.PendingBlueprints.AddPlannedChange(ctx, req, path.Root("blueprint_identifier"))
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("id"), req.ID,
-	)...)
+func (r *AggregationPropertiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importid.Import(ctx, req, resp, "blueprint_identifier")
 }
 
 func (r *AggregationPropertiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -80,6 +80,12 @@ func TestAccPortAggregationPropertyWithCycleRelation(t *testing.T) {
 					resource.TestCheckResourceAttr("port_aggregation_properties.child_aggregation_properties", "properties.count_entities.method.count_entities", "true"),
 				),
 			},
+			{
+				ResourceName:      "port_aggregation_properties.child_aggregation_properties",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     parentBlueprintIdentifier,
+			},
 		},
 	})
 }
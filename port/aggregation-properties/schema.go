@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 )
 
 func AggregationPropertySchema() schema.Attribute {
@@ -113,6 +114,7 @@ func AggregationPropertySchema() schema.Attribute {
 				"query": schema.StringAttribute{
 					MarkdownDescription: "Query to filter the target entities",
 					Optional:            true,
+					CustomType:          jsontypes.NormalizedType{},
 				},
 			},
 		},
@@ -0,0 +1,17 @@
+package api_object
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+)
+
+type ApiObjectModel struct {
+	ID           types.String         `tfsdk:"id"`
+	Path         types.String         `tfsdk:"path"`
+	Identifier   types.String         `tfsdk:"identifier"`
+	Body         jsontypes.Normalized `tfsdk:"body"`
+	UpdateMethod types.String         `tfsdk:"update_method"`
+	ResponseKey    types.String         `tfsdk:"response_key"`
+	ComputedFields []types.String       `tfsdk:"computed_fields"`
+	Output       jsontypes.Normalized `tfsdk:"output"`
+}
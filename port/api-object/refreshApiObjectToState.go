@@ -0,0 +1,95 @@
+package api_object
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/samber/lo"
+)
+
+// metadataFields are the fields Port writes on the objects of every API, they are never compared with the body
+var metadataFields = []string{"createdAt", "createdBy", "updatedAt", "updatedBy"}
+
+// responseObject returns the object in a response of the API, Port returns it under a key, e.g.
+// {"ok": true, "webhook": {...}}
+func responseObject(responseBody []byte, responseKey string) (any, error) {
+	decoded, err := utils.DecodeJSON(responseBody)
+	response, isObject := decoded.(map[string]any)
+	if err != nil || !isObject {
+		return nil, fmt.Errorf("the response isn't a JSON object: %s", responseBody)
+	}
+
+	if responseKey != "" {
+		object, ok := response[responseKey]
+		if !ok {
+			return nil, fmt.Errorf("the response has no %s key: %s", responseKey, responseBody)
+		}
+		return object, nil
+	}
+
+	delete(response, "ok")
+	if len(response) == 1 {
+		for _, object := range response {
+			return object, nil
+		}
+	}
+	return response, nil
+}
+
+func refreshApiObjectToState(state *ApiObjectModel, responseBody []byte) error {
+	object, err := writeApiObjectComputedFieldsToState(state, responseBody)
+	if err != nil {
+		return err
+	}
+
+	if state.UpdateMethod.IsNull() {
+		state.UpdateMethod = types.StringValue(http.MethodPut)
+	}
+	if state.ComputedFields == nil {
+		state.ComputedFields = []types.String{}
+	}
+
+	// an imported object has no body yet, it gets all the fields of the object that aren't empty
+	var configured any = map[string]any{}
+	if !state.Body.IsNull() {
+		if configured, err = utils.DecodeJSON([]byte(state.Body.ValueString())); err != nil {
+			return err
+		}
+	}
+	if o, isObject := object.(map[string]any); isObject {
+		// the fields Port computes are only compared when the body sets them
+		c, _ := configured.(map[string]any)
+		computed := append(utils.TFStringListToStringArray(state.ComputedFields), metadataFields...)
+		object = lo.OmitBy(o, func(field string, _ any) bool {
+			_, isConfigured := c[field]
+			return !isConfigured && lo.Contains(computed, field)
+		})
+	}
+	b, err := json.Marshal(utils.ConfiguredJSONFields(object, configured, nil))
+	if err != nil {
+		return err
+	}
+	state.Body = jsontypes.NewNormalizedValue(string(b))
+	return nil
+}
+
+// writeApiObjectComputedFieldsToState writes the fields that aren't configured, and returns the object in the response
+func writeApiObjectComputedFieldsToState(state *ApiObjectModel, responseBody []byte) (any, error) {
+	object, err := responseObject(responseBody, state.ResponseKey.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	state.ID = types.StringValue(state.Path.ValueString() + importid.Separator + state.Identifier.ValueString())
+	state.Output = jsontypes.NewNormalizedValue(string(output))
+	return object, nil
+}
@@ -0,0 +1,82 @@
+package api_object
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+	"github.com/samber/lo"
+)
+
+// portWebhook is a webhook as Port returns it, with the fields Port computes and a description added outside of Terraform
+const portWebhook = `{
+	"ok": true,
+	"integration": {
+		"identifier": "github",
+		"title": "GitHub",
+		"description": "added in Port",
+		"enabled": true,
+		"mappings": [],
+		"url": "https://ingest.getport.io/key",
+		"webhookKey": "key",
+		"createdAt": "2024-01-01T00:00:00.000Z",
+		"updatedBy": "user"
+	}
+}`
+
+func TestRefreshApiObjectToState(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           *string
+		computedFields []types.String
+		expected       string
+	}{
+		{
+			name:           "fields changed in Port are kept",
+			body:           lo.ToPtr(`{"identifier": "github", "title": "GitHub", "enabled": true}`),
+			computedFields: []types.String{types.StringValue("url"), types.StringValue("webhookKey")},
+			expected:       `{"description":"added in Port","enabled":true,"identifier":"github","title":"GitHub"}`,
+		},
+		{
+			name:           "computed fields are compared when the body sets them",
+			body:           lo.ToPtr(`{"identifier": "github", "title": "GitHub", "enabled": true, "url": "https://example.com", "mappings": []}`),
+			computedFields: []types.String{types.StringValue("url"), types.StringValue("webhookKey")},
+			expected:       `{"description":"added in Port","enabled":true,"identifier":"github","mappings":[],"title":"GitHub","url":"https://ingest.getport.io/key"}`,
+		},
+		{
+			name:           "fields that aren't computed are kept",
+			body:           lo.ToPtr(`{"identifier": "github", "title": "GitHub", "enabled": true}`),
+			computedFields: []types.String{},
+			expected:       `{"description":"added in Port","enabled":true,"identifier":"github","title":"GitHub","url":"https://ingest.getport.io/key","webhookKey":"key"}`,
+		},
+		{
+			name:     "imported",
+			expected: `{"description":"added in Port","enabled":true,"identifier":"github","title":"GitHub","url":"https://ingest.getport.io/key","webhookKey":"key"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &ApiObjectModel{
+				Path:           types.StringValue("v1/webhooks"),
+				Identifier:     types.StringValue("github"),
+				Body:           jsontypes.NewNormalizedNull(),
+				ComputedFields: tt.computedFields,
+			}
+			if tt.body != nil {
+				state.Body = jsontypes.NewNormalizedValue(*tt.body)
+			}
+			if err := refreshApiObjectToState(state, []byte(portWebhook)); err != nil {
+				t.Fatal(err)
+			}
+			if state.Body.ValueString() != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, state.Body.ValueString())
+			}
+			if state.ID != types.StringValue("v1/webhooks:github") || state.UpdateMethod != types.StringValue("PUT") {
+				t.Errorf("expected the id and the default update method, got %s and %s", state.ID, state.UpdateMethod)
+			}
+			if state.ComputedFields == nil {
+				t.Error("expected the computed fields to default to an empty list")
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package api_object
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
+)
+
+var _ resource.Resource = &ApiObjectResource{}
+var _ resource.ResourceWithImportState = &ApiObjectResource{}
+
+func NewApiObjectResource() resource.Resource {
+	return &ApiObjectResource{}
+}
+
+type ApiObjectResource struct {
+	portClient *cli.PortClient
+}
+
+func (r *ApiObjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_object"
+}
+
+func (r *ApiObjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+// objectPath is the path of the object in its collection
+func objectPath(state *ApiObjectModel) string {
+	return state.Path.ValueString() + "/" + url.PathEscape(state.Identifier.ValueString())
+}
+
+func (r *ApiObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ApiObjectModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseBody, statusCode, err := r.portClient.SendRequest(ctx, http.MethodGet, objectPath(state), nil, nil)
+	if err != nil {
+		if statusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read API object", err.Error())
+		return
+	}
+
+	if err = refreshApiObjectToState(state, responseBody); err != nil {
+		resp.Diagnostics.AddError("failed writing API object fields to resource", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ApiObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state *ApiObjectModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseBody, _, err := r.portClient.SendRequest(ctx, http.MethodPost, state.Path.ValueString(), json.RawMessage(state.Body.ValueString()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create API object", err.Error())
+		return
+	}
+
+	if _, err = writeApiObjectComputedFieldsToState(state, responseBody); err != nil {
+		resp.Diagnostics.AddError("failed writing API object fields to resource", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ApiObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state *ApiObjectModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseBody, _, err := r.portClient.SendRequest(ctx, state.UpdateMethod.ValueString(), objectPath(state), json.RawMessage(state.Body.ValueString()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update API object", err.Error())
+		return
+	}
+
+	if _, err = writeApiObjectComputedFieldsToState(state, responseBody); err != nil {
+		resp.Diagnostics.AddError("failed writing API object fields to resource", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ApiObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ApiObjectModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, statusCode, err := r.portClient.SendRequest(ctx, http.MethodDelete, objectPath(state), nil, nil)
+	if err != nil && statusCode != http.StatusNotFound {
+		resp.Diagnostics.AddError("failed to delete API object", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ApiObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importid.Import(ctx, req, resp, "path", "identifier")
+}
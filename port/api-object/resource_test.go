@@ -0,0 +1,69 @@
+package api_object_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func testAccApiObjectWebhookConfig(identifier string, title string) string {
+	return fmt.Sprintf(`
+	resource "port_api_object" "webhook" {
+		path       = "v1/webhooks"
+		identifier = "%[1]s"
+		body = jsonencode({
+			identifier = "%[1]s"
+			title      = "%[2]s"
+			enabled    = true
+		})
+		computed_fields = ["url", "webhookKey"]
+	}`, identifier, title)
+}
+
+func TestAccPortApiObjectWebhook(t *testing.T) {
+	webhookIdentifier := utils.GenID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccApiObjectWebhookConfig(webhookIdentifier, "Test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_api_object.webhook", "id", fmt.Sprintf("v1/webhooks:%s", webhookIdentifier)),
+					resource.TestCheckResourceAttr("port_api_object.webhook", "update_method", "PUT"),
+					resource.TestCheckResourceAttr("port_api_object.webhook", "body", fmt.Sprintf(`{"enabled":true,"identifier":"%s","title":"Test"}`, webhookIdentifier)),
+					resource.TestCheckResourceAttrSet("port_api_object.webhook", "output"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccApiObjectWebhookConfig(webhookIdentifier, "Test Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_api_object.webhook", "body", fmt.Sprintf(`{"enabled":true,"identifier":"%s","title":"Test Updated"}`, webhookIdentifier)),
+					resource.TestCheckResourceAttrWith("port_api_object.webhook", "output", func(value string) error {
+						var output map[string]any
+						if err := json.Unmarshal([]byte(value), &output); err != nil {
+							return err
+						}
+						if output["title"] != "Test Updated" {
+							return fmt.Errorf("expected the output title to be updated, got %v", output["title"])
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				// the computed fields aren't part of the import ID, the body of an imported object has the ones Port returns
+				ResourceName:            "port_api_object.webhook",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           fmt.Sprintf("v1/webhooks:%s", webhookIdentifier),
+				ImportStateVerifyIgnore: []string{"body", "computed_fields"},
+			},
+		},
+	})
+}
@@ -0,0 +1,121 @@
+package api_object
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/validators"
+)
+
+func ApiObjectSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"path": schema.StringAttribute{
+			MarkdownDescription: "The path of the API collection the object is created in, e.g. `v1/webhooks`, the object itself is at `<path>/<identifier>`",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				validators.RequestPath(),
+			},
+		},
+		"identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the object, it must match the identifier in the `body` for the APIs that expect one",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"body": schema.StringAttribute{
+			MarkdownDescription: "The JSON body of the object, the fields of the object in Port that it doesn't set are compared with it too, except for the empty ones and the `computed_fields`",
+			Required:            true,
+			CustomType:          jsontypes.NormalizedType{},
+		},
+		"update_method": schema.StringAttribute{
+			MarkdownDescription: "The HTTP method the object is updated with, `PUT` or `PATCH`, default is `PUT`",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(http.MethodPut),
+			Validators: []validator.String{
+				stringvalidator.OneOf(http.MethodPut, http.MethodPatch),
+			},
+		},
+		"response_key": schema.StringAttribute{
+			MarkdownDescription: "The key of the object in the responses of the API, e.g. `webhook` for `{\"ok\": true, \"webhook\": {...}}`, default is the only key of the response other than `ok`",
+			Optional:            true,
+		},
+		"computed_fields": schema.ListAttribute{
+			MarkdownDescription: "The top-level fields Port computes for the object, e.g. `url` for a webhook, that are only compared with the object in Port when the `body` sets them. The metadata fields `createdAt`, `createdBy`, `updatedAt` and `updatedBy` are always ignored",
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+		},
+		"output": schema.StringAttribute{
+			MarkdownDescription: "The JSON of the object as Port returned it, including the fields Port adds",
+			Computed:            true,
+			CustomType:          jsontypes.NormalizedType{},
+		},
+	}
+}
+
+func (r *ApiObjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ApiObjectResourceMarkdownDescription,
+		Attributes:          ApiObjectSchema(),
+	}
+}
+
+var ApiObjectResourceMarkdownDescription = `
+
+# API Object resource
+
+This resource manages any object of the [Port API](https://docs.getport.io/api-reference/port-api) with its raw JSON body, for the API features that don't have a dedicated resource yet.
+The object is created with a ` + "`POST`" + ` to its ` + "`path`" + `, read with a ` + "`GET`" + `, updated with a ` + "`PUT`" + ` (or ` + "`PATCH`" + `) and deleted with a ` + "`DELETE`" + ` to ` + "`<path>/<identifier>`" + `, using the credentials and the retries of the provider.
+
+The ` + "`path`" + ` is relative to the ` + "`base_url`" + ` of the provider, paths with a scheme or a host are rejected.
+
+The fields of the object in Port that the ` + "`body`" + ` doesn't set are compared with it too, so that the fields changed outside of Terraform cause a diff. The empty fields, the metadata fields such as ` + "`createdAt`" + ` and the fields listed in ` + "`computed_fields`" + ` are only compared when the ` + "`body`" + ` sets them. The object as Port returned it is available in ` + "`output`" + `.
+
+Prefer the dedicated resource when there is one, as the ` + "`body`" + ` isn't validated before it is sent to Port.
+
+## Example Usage
+
+` + "```hcl" + `
+
+resource "port_api_object" "github_webhook" {
+  path       = "v1/webhooks"
+  identifier = "github"
+  body = jsonencode({
+    identifier = "github"
+    title      = "GitHub"
+    enabled    = true
+    mappings   = []
+  })
+  computed_fields = ["url", "webhookKey"]
+}
+
+output "github_webhook_url" {
+  value = jsondecode(port_api_object.github_webhook.output).url
+}
+
+` + "```" + `
+`
@@ -0,0 +1,67 @@
+package api_request
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+)
+
+var _ datasource.DataSource = &ApiRequestDataSource{}
+
+func NewApiRequestDataSource() datasource.DataSource {
+	return &ApiRequestDataSource{}
+}
+
+type ApiRequestDataSource struct {
+	portClient *cli.PortClient
+}
+
+func (d *ApiRequestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (d *ApiRequestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_request"
+}
+
+func (d *ApiRequestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApiRequestDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseBody, statusCode, err := d.portClient.SendRequest(ctx, http.MethodGet, data.Path.ValueString(), nil, data.QueryParams)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to send API request", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(data.requestURL())
+	data.StatusCode = types.Int64Value(int64(statusCode))
+	data.ResponseBody = jsontypes.NewNormalizedValue(string(responseBody))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// requestURL is the path of the request with its query parameters, sorted by key
+func (m *ApiRequestDataModel) requestURL() string {
+	query := url.Values{}
+	for key, value := range m.QueryParams {
+		query.Set(key, value)
+	}
+	if len(query) == 0 {
+		return m.Path.ValueString()
+	}
+	return m.Path.ValueString() + "?" + query.Encode()
+}
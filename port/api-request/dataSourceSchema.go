@@ -0,0 +1,77 @@
+package api_request
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/validators"
+)
+
+func (d *ApiRequestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ApiRequestDataSourceMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The path of the API request, e.g. `v1/blueprints/microservice`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					validators.RequestPath(),
+				},
+			},
+			"query_params": schema.MapAttribute{
+				MarkdownDescription: "The query parameters of the API request",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"status_code": schema.Int64Attribute{
+				MarkdownDescription: "The HTTP status code of the response",
+				Computed:            true,
+			},
+			"response_body": schema.StringAttribute{
+				MarkdownDescription: "The JSON body of the response",
+				Computed:            true,
+				CustomType:          jsontypes.NormalizedType{},
+			},
+		},
+	}
+}
+
+var ApiRequestDataSourceMarkdownDescription = `
+
+# API Request data source
+
+This data source sends a ` + "`GET`" + ` request to any path of the [Port API](https://docs.getport.io/api-reference/port-api), using the credentials and the retries of the provider, for the API features that don't have a dedicated data source yet.
+The ` + "`path`" + ` is relative to the ` + "`base_url`" + ` of the provider, paths with a scheme or a host are rejected. The request fails unless Port returns a successful response.
+Like other data sources, it is read during plan when its arguments are known, so add ` + "`depends_on`" + ` on the resources that create the requested object in the same apply.
+
+## Example Usage
+
+` + "```hcl" + `
+
+data "port_api_request" "microservice" {
+  path = "v1/blueprints/microservice"
+}
+
+output "microservice_properties" {
+  value = keys(jsondecode(data.port_api_request.microservice.response_body).blueprint.schema.properties)
+}
+
+data "port_api_request" "recent_runs" {
+  path = "v1/actions/runs"
+  query_params = {
+    entity = "checkout"
+    limit  = "10"
+  }
+}
+
+` + "```" + `
+`
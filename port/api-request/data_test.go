@@ -0,0 +1,79 @@
+package api_request_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func TestAccPortApiRequestWebhook(t *testing.T) {
+	webhookIdentifier := utils.GenID()
+	var testAccApiRequestConfig = fmt.Sprintf(`
+	resource "port_webhook" "create_pr" {
+		identifier = "%s"
+		title      = "Test"
+		enabled    = true
+	}
+
+	data "port_api_request" "webhook" {
+		path = "v1/webhooks/${port_webhook.create_pr.identifier}"
+
+		# the identifier is known during plan, so the data source is only read after the webhook is created with depends_on
+		depends_on = [port_webhook.create_pr]
+	}
+
+	output "webhook_title" {
+		value = jsondecode(data.port_api_request.webhook.response_body).integration.title
+	}`, webhookIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccApiRequestConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.port_api_request.webhook", "id", fmt.Sprintf("v1/webhooks/%s", webhookIdentifier)),
+					resource.TestCheckResourceAttr("data.port_api_request.webhook", "status_code", "200"),
+					resource.TestCheckOutput("webhook_title", "Test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortApiRequestNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + fmt.Sprintf(`
+	data "port_api_request" "missing" {
+		path = "v1/webhooks/%s"
+	}`, utils.GenID()),
+				ExpectError: regexp.MustCompile("failed to GET v1/webhooks/"),
+			},
+		},
+	})
+}
+
+func TestAccPortApiRequestRejectsOtherHosts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+	data "port_api_request" "other_host" {
+		path = "https://example.com/v1/blueprints"
+	}`,
+				ExpectError: regexp.MustCompile("(?s)must be relative to the Port.*API URL"),
+			},
+		},
+	})
+}
@@ -0,0 +1,14 @@
+package api_request
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+)
+
+type ApiRequestDataModel struct {
+	ID           types.String         `tfsdk:"id"`
+	Path         types.String         `tfsdk:"path"`
+	QueryParams  map[string]string    `tfsdk:"query_params"`
+	StatusCode   types.Int64          `tfsdk:"status_code"`
+	ResponseBody jsontypes.Normalized `tfsdk:"response_body"`
+}
@@ -0,0 +1,14 @@
+package blueprint_json
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+)
+
+type BlueprintJsonModel struct {
+	ID                  types.String         `tfsdk:"id"`
+	Identifier          types.String         `tfsdk:"identifier"`
+	Definition          jsontypes.Normalized `tfsdk:"definition"`
+	CreateCatalogPage   types.Bool           `tfsdk:"create_catalog_page"`
+	ForceDeleteEntities types.Bool           `tfsdk:"force_delete_entities"`
+}
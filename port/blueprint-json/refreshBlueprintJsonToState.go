@@ -0,0 +1,78 @@
+package blueprint_json
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/samber/lo"
+)
+
+// ignoredDefinitionFields are the fields of an exported blueprint that the definition doesn't manage, the metadata
+// Port writes and the aggregation properties, that are managed by the port_aggregation_properties resource
+var ignoredDefinitionFields = []string{"createdAt", "createdBy", "updatedAt", "updatedBy", "aggregationProperties"}
+
+// blueprintDefaults are the values Port fills in for the fields of a blueprint that aren't set, besides the empty ones
+var blueprintDefaults = map[string]any{"ownership": map[string]any{"type": "Direct"}}
+
+// definitionToPortBody decodes the definition into the blueprint body and its identifier. The fields are sent as they
+// are, so that Port validates them, except for the ignored ones.
+func definitionToPortBody(definition string) (map[string]any, string, error) {
+	decoded, err := utils.DecodeJSON([]byte(definition))
+	if err != nil {
+		return nil, "", fmt.Errorf("the definition isn't valid JSON: %w", err)
+	}
+	b, isObject := decoded.(map[string]any)
+	if !isObject {
+		return nil, "", fmt.Errorf("the definition must be a JSON object")
+	}
+	identifier, _ := b["identifier"].(string)
+	if identifier == "" {
+		return nil, "", fmt.Errorf("the definition has no identifier")
+	}
+	return lo.OmitByKeys(b, ignoredDefinitionFields), identifier, nil
+}
+
+// refreshBlueprintJsonState writes the blueprint, as Port returns it, to the state. The definition has the values of
+// the fields in Port, without the defaults Port fills in for the fields that aren't configured, so that the fields
+// added outside of Terraform show as a diff.
+func refreshBlueprintJsonState(state *BlueprintJsonModel, b map[string]any) error {
+	identifier, _ := b["identifier"].(string)
+	state.ID = types.StringValue(identifier)
+	state.Identifier = types.StringValue(identifier)
+	// an imported blueprint has the default values of the arguments that aren't read from Port
+	if state.CreateCatalogPage.IsNull() {
+		state.CreateCatalogPage = types.BoolValue(true)
+	}
+	if state.ForceDeleteEntities.IsNull() {
+		state.ForceDeleteEntities = types.BoolValue(false)
+	}
+
+	blueprint := lo.OmitByKeys(b, ignoredDefinitionFields)
+
+	// an imported blueprint has no definition yet, it gets all the fields of the blueprint that aren't defaults
+	var configured any = map[string]any{}
+	if !state.Definition.IsNull() {
+		var err error
+		if configured, err = utils.DecodeJSON([]byte(state.Definition.ValueString())); err != nil {
+			return err
+		}
+		if c, ok := configured.(map[string]any); ok {
+			// the ignored fields keep their configured values, so they never cause a diff
+			for _, field := range ignoredDefinitionFields {
+				if value, ok := c[field]; ok {
+					blueprint[field] = value
+				}
+			}
+		}
+	}
+
+	d, err := json.Marshal(utils.ConfiguredJSONFields(blueprint, configured, blueprintDefaults))
+	if err != nil {
+		return err
+	}
+	state.Definition = jsontypes.NewNormalizedValue(string(d))
+	return nil
+}
@@ -0,0 +1,145 @@
+package blueprint_json
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/samber/lo"
+)
+
+// portBlueprint is a blueprint as Port returns it, with the metadata, the defaults Port adds and the empty fields
+const portBlueprint = `{
+	"identifier": "microservice",
+	"title": "Microservice",
+	"icon": "Microservice",
+	"description": "",
+	"schema": {
+		"properties": {
+			"language": {"type": "string", "title": "Language", "enum": [], "default": ""},
+			"replicas": {"type": "number", "title": "Replicas", "default": 0},
+			"public": {"type": "boolean", "title": "Public", "default": false}
+		},
+		"required": []
+	},
+	"mirrorProperties": {},
+	"calculationProperties": {},
+	"aggregationProperties": {"count": {"title": "Count"}},
+	"relations": {},
+	"ownership": {"type": "Direct"},
+	"createdAt": "2024-01-01T00:00:00.000Z",
+	"createdBy": "user",
+	"updatedAt": "2024-01-02T00:00:00.000Z",
+	"updatedBy": "user"
+}`
+
+func decodeObject(t *testing.T, s string) map[string]any {
+	decoded, err := utils.DecodeJSON([]byte(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decoded.(map[string]any)
+}
+
+func TestRefreshBlueprintJsonState(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition *string
+		expected   string
+	}{
+		{
+			name: "empty lists and objects and falsy values are kept",
+			definition: lo.ToPtr(`{
+				"identifier": "microservice",
+				"title": "Microservice",
+				"icon": "Microservice",
+				"description": "",
+				"schema": {
+					"properties": {
+						"language": {"type": "string", "title": "Language", "enum": [], "default": ""},
+						"replicas": {"type": "number", "title": "Replicas", "default": 0},
+						"public": {"type": "boolean", "title": "Public", "default": false}
+					},
+					"required": []
+				},
+				"relations": {}
+			}`),
+			expected: `{"description":"","icon":"Microservice","identifier":"microservice","relations":{},"schema":{"properties":{"language":{"default":"","enum":[],"title":"Language","type":"string"},"public":{"default":false,"title":"Public","type":"boolean"},"replicas":{"default":0,"title":"Replicas","type":"number"}},"required":[]},"title":"Microservice"}`,
+		},
+		{
+			name:       "null fields that Port omits stay null",
+			definition: lo.ToPtr(`{"identifier": "microservice", "title": "Microservice", "changelogDestination": null}`),
+			expected:   `{"changelogDestination":null,"icon":"Microservice","identifier":"microservice","schema":{"properties":{"language":{"title":"Language","type":"string"},"public":{"title":"Public","type":"boolean"},"replicas":{"title":"Replicas","type":"number"}}},"title":"Microservice"}`,
+		},
+		{
+			name:       "the fields that aren't configured are kept unless they hold a default value",
+			definition: lo.ToPtr(`{"identifier": "microservice", "title": "Microservice"}`),
+			expected:   `{"icon":"Microservice","identifier":"microservice","schema":{"properties":{"language":{"title":"Language","type":"string"},"public":{"title":"Public","type":"boolean"},"replicas":{"title":"Replicas","type":"number"}}},"title":"Microservice"}`,
+		},
+		{
+			name:       "the properties added in Port are kept",
+			definition: lo.ToPtr(`{"identifier": "microservice", "title": "Microservice", "icon": "Microservice", "schema": {"properties": {"language": {"type": "string", "title": "Language"}}}}`),
+			expected:   `{"icon":"Microservice","identifier":"microservice","schema":{"properties":{"language":{"title":"Language","type":"string"},"public":{"title":"Public","type":"boolean"},"replicas":{"title":"Replicas","type":"number"}}},"title":"Microservice"}`,
+		},
+		{
+			name:       "the ignored fields keep their configured values",
+			definition: lo.ToPtr(`{"identifier": "microservice", "title": "Microservice", "icon": "Microservice", "schema": {"properties": {}}, "createdAt": "2020-01-01T00:00:00.000Z", "aggregationProperties": {}}`),
+			expected:   `{"aggregationProperties":{},"createdAt":"2020-01-01T00:00:00.000Z","icon":"Microservice","identifier":"microservice","schema":{"properties":{"language":{"title":"Language","type":"string"},"public":{"title":"Public","type":"boolean"},"replicas":{"title":"Replicas","type":"number"}}},"title":"Microservice"}`,
+		},
+		{
+			name:     "an imported blueprint gets all the fields but the ignored ones and the defaults",
+			expected: `{"icon":"Microservice","identifier":"microservice","schema":{"properties":{"language":{"title":"Language","type":"string"},"public":{"title":"Public","type":"boolean"},"replicas":{"title":"Replicas","type":"number"}}},"title":"Microservice"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &BlueprintJsonModel{Definition: jsontypes.NewNormalizedNull()}
+			if tt.definition != nil {
+				state.Definition = jsontypes.NewNormalizedValue(*tt.definition)
+			}
+			if err := refreshBlueprintJsonState(state, decodeObject(t, portBlueprint)); err != nil {
+				t.Fatal(err)
+			}
+			if state.Definition.ValueString() != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, state.Definition.ValueString())
+			}
+			if state.Identifier != types.StringValue("microservice") || state.ID != types.StringValue("microservice") {
+				t.Errorf("expected the identifier to be microservice, got %s", state.Identifier)
+			}
+			if !state.CreateCatalogPage.ValueBool() || state.ForceDeleteEntities.ValueBool() {
+				t.Error("expected the arguments that aren't read from Port to have their default values")
+			}
+		})
+	}
+}
+
+func TestDefinitionToPortBody(t *testing.T) {
+	b, identifier, err := definitionToPortBody(`{"identifier": "microservice", "title": "Microservice", "ownership": {"type": "Direct"}, "schema": {"properties": {}, "required": []}, "createdAt": "2024-01-01T00:00:00.000Z", "aggregationProperties": {}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identifier != "microservice" {
+		t.Errorf("expected the identifier to be microservice, got %s", identifier)
+	}
+	body, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the fields are sent as they are, even those the provider doesn't know, but the ignored ones
+	expected := `{"identifier":"microservice","ownership":{"type":"Direct"},"schema":{"properties":{},"required":[]},"title":"Microservice"}`
+	if string(body) != expected {
+		t.Errorf("expected %s, got %s", expected, body)
+	}
+
+	for definition, expectedError := range map[string]string{
+		`{"title": "Microservice"}`: "the definition has no identifier",
+		`["microservice"]`:          "the definition must be a JSON object",
+		`{"identifier": `:           "the definition isn't valid JSON: unexpected EOF",
+	} {
+		if _, _, err = definitionToPortBody(definition); err == nil || err.Error() != expectedError {
+			t.Errorf("expected %q for %s, got %v", expectedError, definition, err)
+		}
+	}
+}
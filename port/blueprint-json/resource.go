@@ -0,0 +1,190 @@
+package blueprint_json
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint"
+)
+
+var _ resource.Resource = &BlueprintJsonResource{}
+var _ resource.ResourceWithImportState = &BlueprintJsonResource{}
+var _ resource.ResourceWithModifyPlan = &BlueprintJsonResource{}
+
+func NewBlueprintJsonResource() resource.Resource {
+	return &BlueprintJsonResource{}
+}
+
+type BlueprintJsonResource struct {
+	portClient *cli.PortClient
+}
+
+func (r *BlueprintJsonResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint_json"
+}
+
+func (r *BlueprintJsonResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (r *BlueprintJsonResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *BlueprintJsonModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	b, statusCode, err := r.portClient.ReadBlueprintJSON(ctx, state.Identifier.ValueString())
+	if err != nil {
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading blueprint", err.Error())
+		return
+	}
+
+	if err = refreshBlueprintJsonState(state, b); err != nil {
+		resp.Diagnostics.AddError("failed writing blueprint fields to resource", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BlueprintJsonResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state *BlueprintJsonModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	b, identifier, err := definitionToPortBody(state.Definition.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("definition"), "invalid blueprint definition", err.Error())
+		return
+	}
+
+	if _, err = r.portClient.CreateBlueprintJSON(ctx, b, state.CreateCatalogPage.ValueBoolPointer()); err != nil {
+		resp.Diagnostics.AddError("failed to create blueprint", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(identifier)
+	state.Identifier = types.StringValue(identifier)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BlueprintJsonResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state *BlueprintJsonModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	b, identifier, err := definitionToPortBody(state.Definition.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("definition"), "invalid blueprint definition", err.Error())
+		return
+	}
+
+	existingBp, statusCode, err := r.portClient.ReadBlueprintJSON(ctx, identifier)
+	if err != nil {
+		if statusCode == 404 {
+			resp.Diagnostics.AddError("Blueprint doesn't exists, it is required to update the blueprint", err.Error())
+			return
+		}
+		resp.Diagnostics.AddError("failed reading blueprint", err.Error())
+		return
+	}
+	// aggregation properties are managed in a different resource, so we need to keep them in the update
+	// to avoid losing them
+	if aggregationProperties, ok := existingBp["aggregationProperties"]; ok {
+		b["aggregationProperties"] = aggregationProperties
+	}
+
+	if _, err = r.portClient.UpdateBlueprintJSON(ctx, b, identifier); err != nil {
+		resp.Diagnostics.AddError("failed to update blueprint", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(identifier)
+	state.Identifier = types.StringValue(identifier)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BlueprintJsonResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *BlueprintJsonModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(blueprint.DeleteBlueprint(ctx, r.portClient, state.Identifier.ValueString(), state.ForceDeleteEntities.ValueBool())...)
+}
+
+func (r *BlueprintJsonResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.portClient != nil {
+		r.portClient.PendingBlueprints.AddPlannedChange(ctx, req, path.Root("identifier"))
+	}
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *BlueprintJsonModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Definition.IsUnknown() {
+		return
+	}
+
+	_, identifier, err := definitionToPortBody(plan.Definition.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("definition"), "invalid blueprint definition", err.Error())
+		return
+	}
+
+	// the identifier is part of the definition, a different one is a different blueprint
+	plan.ID = types.StringValue(identifier)
+	plan.Identifier = types.StringValue(identifier)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	// the identifier is only known here for new blueprints, so the blueprints that relate to it find it in the plan
+	if r.portClient != nil && utils.HasPlannedChanges(req) {
+		r.portClient.PendingBlueprints.Add(identifier)
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state *BlueprintJsonModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.Identifier.ValueString() != identifier {
+		resp.RequiresReplace.Append(path.Root("identifier"))
+	}
+}
+
+func (r *BlueprintJsonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importid.Import(ctx, req, resp, "identifier")
+}
@@ -0,0 +1,147 @@
+package blueprint_json_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func testAccBlueprintJsonConfig(identifier string, title string) string {
+	return fmt.Sprintf(`
+	resource "port_blueprint_json" "microservice" {
+		definition = jsonencode({
+			identifier = "%s"
+			title      = "%s"
+			icon       = "Terraform"
+			schema = {
+				properties = {
+					language = {
+						type  = "string"
+						title = "Language"
+						enum  = ["Go", "Python"]
+					}
+				}
+			}
+		})
+	}`, identifier, title)
+}
+
+func TestAccPortBlueprintJsonBasic(t *testing.T) {
+	identifier := utils.GenID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccBlueprintJsonConfig(identifier, "Microservice"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint_json.microservice", "id", identifier),
+					resource.TestCheckResourceAttr("port_blueprint_json.microservice", "identifier", identifier),
+					resource.TestCheckResourceAttr("port_blueprint_json.microservice", "create_catalog_page", "true"),
+					resource.TestCheckResourceAttr("port_blueprint_json.microservice", "force_delete_entities", "false"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccBlueprintJsonConfig(identifier, "Microservice Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint_json.microservice", "identifier", identifier),
+					resource.TestMatchResourceAttr("port_blueprint_json.microservice", "definition", regexp.MustCompile(`"title":"Microservice Updated"`)),
+				),
+			},
+			{
+				// the definition of an imported blueprint has all the fields Port returns but the defaults
+				ResourceName:      "port_blueprint_json.microservice",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     identifier,
+			},
+		},
+	})
+}
+
+func TestAccPortBlueprintJsonKeepsAggregationProperties(t *testing.T) {
+	parentIdentifier := utils.GenID()
+	childIdentifier := utils.GenID()
+
+	config := func(title string) string {
+		return fmt.Sprintf(`
+	resource "port_blueprint_json" "parent" {
+		definition = jsonencode({
+			identifier = "%[1]s"
+			title      = "%[3]s"
+			schema = {
+				properties = {}
+			}
+		})
+	}
+
+	resource "port_blueprint" "child" {
+		title      = "Child Blueprint"
+		icon       = "Terraform"
+		identifier = "%[2]s"
+		relations = {
+			"parent" = {
+				title  = "Parent"
+				target = port_blueprint_json.parent.identifier
+			}
+		}
+	}
+
+	resource "port_aggregation_properties" "parent" {
+		blueprint_identifier = port_blueprint_json.parent.identifier
+		properties = {
+			"count_children" = {
+				target_blueprint_identifier = port_blueprint.child.identifier
+				title                       = "Count Children"
+				method = {
+					count_entities = true
+				}
+			}
+		}
+	}`, parentIdentifier, childIdentifier, title)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + config("Parent"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_aggregation_properties.parent", "properties.count_children.title", "Count Children"),
+				),
+			},
+			{
+				// updating the blueprint keeps its aggregation properties, so the plan after the apply is empty
+				Config: acctest.ProviderConfig + config("Parent Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("port_blueprint_json.parent", "definition", regexp.MustCompile(`"title":"Parent Updated"`)),
+					resource.TestCheckResourceAttr("port_aggregation_properties.parent", "properties.count_children.title", "Count Children"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortBlueprintJsonInvalidDefinition(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + `
+	resource "port_blueprint_json" "invalid" {
+		definition = jsonencode({
+			title = "Invalid"
+		})
+	}`,
+				ExpectError: regexp.MustCompile(`the definition has no identifier`),
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package blueprint_json
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+)
+
+func BlueprintJsonSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the blueprint, taken from the `definition`, changing it replaces the blueprint",
+			Computed:            true,
+		},
+		"definition": schema.StringAttribute{
+			MarkdownDescription: "The JSON definition of the blueprint, in the format Port exports blueprints in. The fields Port fills with their default values are only compared when it sets them, the metadata fields and the aggregation properties in it are ignored",
+			Required:            true,
+			CustomType:          jsontypes.NormalizedType{},
+		},
+		"create_catalog_page": schema.BoolAttribute{
+			MarkdownDescription: "This flag is only relevant for blueprint creation, by default if not set, a catalog page will be created for the blueprint",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"force_delete_entities": schema.BoolAttribute{
+			MarkdownDescription: "If set to true, the blueprint will be deleted with all its entities, even if they are not managed by Terraform",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+}
+
+func (r *BlueprintJsonResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: BlueprintJsonResourceMarkdownDescription,
+		Attributes:          BlueprintJsonSchema(),
+	}
+}
+
+var BlueprintJsonResourceMarkdownDescription = `
+
+# Blueprint JSON resource
+
+This resource manages a [blueprint](https://docs.getport.io/build-your-software-catalog/define-your-data-model/setup-blueprint/) from its JSON definition, in the same format Port exports blueprints in, so that the blueprints exported from Port can be kept in files as they are.
+
+The fields Port adds with their default values are only compared when the definition sets them, so they don't cause a diff, while the other fields changed in Port show up in the plan.
+The ` + "`createdAt`" + `, ` + "`createdBy`" + `, ` + "`updatedAt`" + ` and ` + "`updatedBy`" + ` fields of an exported blueprint are ignored, and so are its ` + "`aggregationProperties`" + `, they are managed with the ` + "`port_aggregation_properties`" + ` resource and are kept when the blueprint is updated.
+
+## Example Usage
+
+` + "```hcl" + `
+
+resource "port_blueprint_json" "microservice" {
+  definition = file("${path.module}/blueprints/microservice.json")
+}
+
+resource "port_blueprint_json" "environment" {
+  definition = jsonencode({
+    identifier = "environment"
+    title      = "Environment"
+    icon       = "Environment"
+    schema = {
+      properties = {
+        type = {
+          type  = "string"
+          title = "Type"
+          enum  = ["production", "staging", "development"]
+        }
+      }
+      required = []
+    }
+    mirrorProperties      = {}
+    calculationProperties = {}
+    relations             = {}
+  })
+}
+
+` + "```" + `
+
+## Example Usage with Force Delete
+
+Like the ` + "`port_blueprint`" + ` resource, a blueprint with entities can only be destroyed when ` + "`force_delete_entities`" + ` is set to true, which deletes all the entities of the blueprint with it.
+
+` + "```hcl" + `
+
+resource "port_blueprint_json" "microservice" {
+  definition            = file("${path.module}/blueprints/microservice.json")
+  force_delete_entities = true
+}
+
+` + "```" + `
+`
@@ -2,10 +2,10 @@ package blueprint_permissions
 
 import (
 	"context"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
 )
 
 var _ resource.Resource = &BlueprintPermissionsResource{}
@@ -32,13 +32,7 @@ func (r *BlueprintPermissionsResource) Configure(ctx context.Context, req resour
 }
 
 func (r *BlueprintPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("blueprint_identifier"), req.ID,
-	)...)
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("id"), req.ID,
-	)...)
+	importid.Import(ctx, req, resp, "blueprint_identifier")
 }
 
 func (r *BlueprintPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -2,7 +2,6 @@ package blueprint_permissions_test
 
 import (
 	"fmt"
-	"os"
 	"regexp"
 	"testing"
 
@@ -57,10 +56,6 @@ resource "port_blueprint" "microservice" {
 
 func TestAccPortBlueprintPermissionsBasic(t *testing.T) {
 	blueprintIdentifier := utils.GenID()
-	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
-	if err != nil {
-		t.Fatal(err)
-	}
 	var testAccPortBlueprintResourceBasic = createBlueprint(blueprintIdentifier)
 
 	var testAccBaseBlueprintPermissionsConfigUpdate = `
@@ -150,16 +145,18 @@ func TestAccPortBlueprintPermissionsBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("port_blueprint_permissions.microservice_permissions", "entities.update_metadata_properties.icon.roles.0", "Member"),
 				),
 			},
+			{
+				ResourceName:      "port_blueprint_permissions.microservice_permissions",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     blueprintIdentifier,
+			},
 		},
 	})
 }
 
 func TestAccPortBlueprintPermissionsWithProperties(t *testing.T) {
 	blueprintIdentifier := utils.GenID()
-	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
-	if err != nil {
-		t.Fatal(err)
-	}
 	var testAccPortBlueprintResourceBasic = createBlueprintWithProperties(blueprintIdentifier)
 
 	var testAccBaseBlueprintPermissionsConfigUpdate = `
@@ -262,10 +259,6 @@ func TestAccPortBlueprintPermissionsWithProperties(t *testing.T) {
 func TestAccPortBlueprintPermissionsWithRelations(t *testing.T) {
 	blueprintMicroserviceIdentifier := utils.GenID()
 	blueprintEnvIdentifier := utils.GenID()
-	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
-	if err != nil {
-		t.Fatal(err)
-	}
 	var testAccPortBlueprintResourceBasic = fmt.Sprintf(`
 resource "port_blueprint" "environment" {
   title      = "Environment"
@@ -412,10 +405,6 @@ resource "port_blueprint" "microservice" {
 
 func TestAccPortBlueprintPermissionsWithInvalidProperties(t *testing.T) {
 	blueprintIdentifier := utils.GenID()
-	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
-	if err != nil {
-		t.Fatal(err)
-	}
 	var testAccPortBlueprintResourceBasic = createBlueprintWithProperties(blueprintIdentifier)
 
 	teamName := utils.GenID()
@@ -0,0 +1,17 @@
+package blueprint_property
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint"
+)
+
+type BlueprintPropertyModel struct {
+	ID                  types.String                `tfsdk:"id"`
+	BlueprintIdentifier types.String                `tfsdk:"blueprint_identifier"`
+	PropertyIdentifier  types.String                `tfsdk:"property_identifier"`
+	StringProp          *blueprint.StringPropModel  `tfsdk:"string_prop"`
+	NumberProp          *blueprint.NumberPropModel  `tfsdk:"number_prop"`
+	BooleanProp         *blueprint.BooleanPropModel `tfsdk:"boolean_prop"`
+	ArrayProp           *blueprint.ArrayPropModel   `tfsdk:"array_prop"`
+	ObjectProp          *blueprint.ObjectPropModel  `tfsdk:"object_prop"`
+}
@@ -0,0 +1,45 @@
+package blueprint_property
+
+import (
+	"context"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint"
+	"github.com/samber/lo"
+)
+
+func propertyToPortBody(ctx context.Context, state *BlueprintPropertyModel) (*cli.BlueprintProperty, bool, error) {
+	identifier := state.PropertyIdentifier.ValueString()
+	properties := &blueprint.PropertiesModel{}
+
+	if state.StringProp != nil {
+		properties.StringProps = map[string]blueprint.StringPropModel{identifier: *state.StringProp}
+	}
+	if state.NumberProp != nil {
+		properties.NumberProps = map[string]blueprint.NumberPropModel{identifier: *state.NumberProp}
+	}
+	if state.BooleanProp != nil {
+		properties.BooleanProps = map[string]blueprint.BooleanPropModel{identifier: *state.BooleanProp}
+	}
+	if state.ArrayProp != nil {
+		properties.ArrayProps = map[string]blueprint.ArrayPropModel{identifier: *state.ArrayProp}
+	}
+	if state.ObjectProp != nil {
+		properties.ObjectProps = map[string]blueprint.ObjectPropModel{identifier: *state.ObjectProp}
+	}
+
+	props, required, err := blueprint.PropertiesToBody(ctx, properties)
+	if err != nil {
+		return nil, false, err
+	}
+
+	property := props[identifier]
+	return &property, lo.Contains(required, identifier), nil
+}
+
+func setPropertyRequired(b *cli.Blueprint, identifier string, required bool) {
+	b.Schema.Required = lo.Without(b.Schema.Required, identifier)
+	if required {
+		b.Schema.Required = append(b.Schema.Required, identifier)
+	}
+}
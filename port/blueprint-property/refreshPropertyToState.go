@@ -0,0 +1,43 @@
+package blueprint_property
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint"
+)
+
+func refreshPropertyState(ctx context.Context, state *BlueprintPropertyModel, b *cli.Blueprint) {
+	identifier := state.PropertyIdentifier.ValueString()
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s", b.Identifier, identifier))
+	state.BlueprintIdentifier = types.StringValue(b.Identifier)
+
+	properties := blueprint.PropertiesToState(ctx, cli.BlueprintSchema{
+		Properties: map[string]cli.BlueprintProperty{identifier: b.Schema.Properties[identifier]},
+		Required:   b.Schema.Required,
+	})
+
+	state.StringProp = nil
+	state.NumberProp = nil
+	state.BooleanProp = nil
+	state.ArrayProp = nil
+	state.ObjectProp = nil
+
+	if prop, ok := properties.StringProps[identifier]; ok {
+		state.StringProp = &prop
+	}
+	if prop, ok := properties.NumberProps[identifier]; ok {
+		state.NumberProp = &prop
+	}
+	if prop, ok := properties.BooleanProps[identifier]; ok {
+		state.BooleanProp = &prop
+	}
+	if prop, ok := properties.ArrayProps[identifier]; ok {
+		state.ArrayProp = &prop
+	}
+	if prop, ok := properties.ObjectProps[identifier]; ok {
+		state.ObjectProp = &prop
+	}
+}
@@ -0,0 +1,222 @@
+package blueprint_property
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+var _ resource.Resource = &BlueprintPropertyResource{}
+var _ resource.ResourceWithImportState = &BlueprintPropertyResource{}
+var _ resource.ResourceWithModifyPlan = &BlueprintPropertyResource{}
+
+func NewBlueprintPropertyResource() resource.Resource {
+	return &BlueprintPropertyResource{}
+}
+
+type BlueprintPropertyResource struct {
+	portClient *cli.PortClient
+}
+
+func (r *BlueprintPropertyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint_property"
+}
+
+func (r *BlueprintPropertyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+// ModifyPlan records the blueprint as changed, the plan validations of the resources that use it don't fail on the
+// parts of it that are only changed by the same apply
+func (r *BlueprintPropertyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.portClient == nil {
+		return
+	}
+	r.portClient.PendingBlueprints.AddPlannedChange(ctx, req, path.Root("blueprint_identifier"))
+}
+
+func (r *BlueprintPropertyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importid.Import(ctx, req, resp, "blueprint_identifier", "property_identifier")
+}
+
+func (r *BlueprintPropertyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *BlueprintPropertyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	b, statusCode, err := r.portClient.ReadBlueprint(ctx, state.BlueprintIdentifier.ValueString())
+	if err != nil {
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading blueprint", err.Error())
+		return
+	}
+
+	if _, ok := b.Schema.Properties[state.PropertyIdentifier.ValueString()]; !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	refreshPropertyState(ctx, state, b)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BlueprintPropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state *BlueprintPropertyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	property, required, err := propertyToPortBody(ctx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to convert blueprint property to port valid request", err.Error())
+		return
+	}
+
+	blueprintIdentifier := state.BlueprintIdentifier.ValueString()
+	propertyIdentifier := state.PropertyIdentifier.ValueString()
+
+	unlock := utils.BlueprintMutex.Lock(blueprintIdentifier)
+	defer unlock()
+
+	b, statusCode, err := r.portClient.ReadBlueprint(ctx, blueprintIdentifier)
+	if err != nil {
+		if statusCode == 404 {
+			resp.Diagnostics.AddError("Blueprint doesn't exists, it is required to create a blueprint property", err.Error())
+			return
+		}
+		resp.Diagnostics.AddError("failed reading blueprint", err.Error())
+		return
+	}
+
+	if _, ok := b.Schema.Properties[propertyIdentifier]; ok {
+		resp.Diagnostics.AddError("blueprint property already exists", fmt.Sprintf("property %s already exists in blueprint %s", propertyIdentifier, blueprintIdentifier))
+		return
+	}
+
+	if b.Schema.Properties == nil {
+		b.Schema.Properties = map[string]cli.BlueprintProperty{}
+	}
+	b.Schema.Properties[propertyIdentifier] = *property
+	setPropertyRequired(b, propertyIdentifier, required)
+
+	_, err = r.portClient.UpdateBlueprint(ctx, b, blueprintIdentifier)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create blueprint property", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s", blueprintIdentifier, propertyIdentifier))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BlueprintPropertyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state *BlueprintPropertyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	property, required, err := propertyToPortBody(ctx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to convert blueprint property to port valid request", err.Error())
+		return
+	}
+
+	blueprintIdentifier := state.BlueprintIdentifier.ValueString()
+	propertyIdentifier := state.PropertyIdentifier.ValueString()
+
+	unlock := utils.BlueprintMutex.Lock(blueprintIdentifier)
+	defer unlock()
+
+	b, statusCode, err := r.portClient.ReadBlueprint(ctx, blueprintIdentifier)
+	if err != nil {
+		if statusCode == 404 {
+			resp.Diagnostics.AddError("Blueprint doesn't exists, it is required to update a blueprint property", err.Error())
+			return
+		}
+		resp.Diagnostics.AddError("failed reading blueprint", err.Error())
+		return
+	}
+
+	if b.Schema.Properties == nil {
+		b.Schema.Properties = map[string]cli.BlueprintProperty{}
+	}
+	b.Schema.Properties[propertyIdentifier] = *property
+	setPropertyRequired(b, propertyIdentifier, required)
+
+	_, err = r.portClient.UpdateBlueprint(ctx, b, blueprintIdentifier)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update blueprint property", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s", blueprintIdentifier, propertyIdentifier))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BlueprintPropertyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *BlueprintPropertyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blueprintIdentifier := state.BlueprintIdentifier.ValueString()
+	propertyIdentifier := state.PropertyIdentifier.ValueString()
+
+	unlock := utils.BlueprintMutex.Lock(blueprintIdentifier)
+	defer unlock()
+
+	b, statusCode, err := r.portClient.ReadBlueprint(ctx, blueprintIdentifier)
+	if err != nil {
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading blueprint", err.Error())
+		return
+	}
+
+	if _, ok := b.Schema.Properties[propertyIdentifier]; !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	delete(b.Schema.Properties, propertyIdentifier)
+	setPropertyRequired(b, propertyIdentifier, false)
+
+	_, err = r.portClient.UpdateBlueprint(ctx, b, blueprintIdentifier)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete blueprint property", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
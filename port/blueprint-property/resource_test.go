@@ -0,0 +1,249 @@
+package blueprint_property_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func baseBlueprintTemplate(blueprintIdentifier string) string {
+	return fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "Microservice"
+		icon = "Terraform"
+		identifier = "%s"
+		description = ""
+		ignore_external_properties = true
+		properties = {
+			string_props = {
+				"language" = {
+					title = "Language"
+				}
+			}
+		}
+	}
+`, blueprintIdentifier)
+}
+
+func TestAccPortBlueprintPropertyString(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	var testAccConfigCreate = baseBlueprintTemplate(blueprintIdentifier) + `
+	resource "port_blueprint_property" "tier" {
+		blueprint_identifier = port_blueprint.microservice.identifier
+		property_identifier = "tier"
+		string_prop = {
+			title = "Tier"
+			required = true
+			enum = ["gold", "silver"]
+		}
+	}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint_property.tier", "id", fmt.Sprintf("%s:tier", blueprintIdentifier)),
+					resource.TestCheckResourceAttr("port_blueprint_property.tier", "blueprint_identifier", blueprintIdentifier),
+					resource.TestCheckResourceAttr("port_blueprint_property.tier", "property_identifier", "tier"),
+					resource.TestCheckResourceAttr("port_blueprint_property.tier", "string_prop.title", "Tier"),
+					resource.TestCheckResourceAttr("port_blueprint_property.tier", "string_prop.required", "true"),
+					resource.TestCheckResourceAttr("port_blueprint_property.tier", "string_prop.enum.0", "gold"),
+					resource.TestCheckResourceAttr("port_blueprint_property.tier", "string_prop.enum.1", "silver"),
+					resource.TestCheckResourceAttr("port_blueprint.microservice", "properties.string_props.%", "1"),
+					resource.TestCheckResourceAttr("port_blueprint.microservice", "properties.string_props.language.title", "Language"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortBlueprintPropertyUpdate(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	var testAccConfigCreate = baseBlueprintTemplate(blueprintIdentifier) + `
+	resource "port_blueprint_property" "replicas" {
+		blueprint_identifier = port_blueprint.microservice.identifier
+		property_identifier = "replicas"
+		number_prop = {
+			title = "Replicas"
+			minimum = 1
+		}
+	}
+`
+
+	var testAccConfigUpdate = baseBlueprintTemplate(blueprintIdentifier) + `
+	resource "port_blueprint_property" "replicas" {
+		blueprint_identifier = port_blueprint.microservice.identifier
+		property_identifier = "replicas"
+		array_prop = {
+			title = "Replicas"
+			string_items = {}
+		}
+	}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint_property.replicas", "number_prop.title", "Replicas"),
+					resource.TestCheckResourceAttr("port_blueprint_property.replicas", "number_prop.minimum", "1"),
+					resource.TestCheckResourceAttr("port_blueprint_property.replicas", "number_prop.required", "false"),
+					resource.TestCheckNoResourceAttr("port_blueprint_property.replicas", "array_prop"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint_property.replicas", "array_prop.title", "Replicas"),
+					resource.TestCheckNoResourceAttr("port_blueprint_property.replicas", "number_prop"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortBlueprintPropertyBlueprintUpdateKeepsProperty(t *testing.T) {
+	// Test checks that updating the blueprint doesn't remove the properties managed by port_blueprint_property
+	blueprintIdentifier := utils.GenID()
+	var propertyConfig = `
+	resource "port_blueprint_property" "on_call" {
+		blueprint_identifier = port_blueprint.microservice.identifier
+		property_identifier = "on_call"
+		boolean_prop = {
+			title = "On Call"
+		}
+	}
+`
+	var testAccConfigUpdate = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "Microservice Updated"
+		icon = "Terraform"
+		identifier = "%s"
+		description = ""
+		ignore_external_properties = true
+		properties = {
+			string_props = {
+				"language" = {
+					title = "Language"
+				}
+			}
+		}
+	}
+`, blueprintIdentifier) + propertyConfig
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + baseBlueprintTemplate(blueprintIdentifier) + propertyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint_property.on_call", "boolean_prop.title", "On Call"),
+					resource.TestCheckNoResourceAttr("port_blueprint.microservice", "properties.boolean_props"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint.microservice", "title", "Microservice Updated"),
+					resource.TestCheckResourceAttr("port_blueprint_property.on_call", "boolean_prop.title", "On Call"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortBlueprintPropertyImport(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	var testAccConfigCreate = baseBlueprintTemplate(blueprintIdentifier) + `
+	resource "port_blueprint_property" "metadata" {
+		blueprint_identifier = port_blueprint.microservice.identifier
+		property_identifier = "metadata"
+		object_prop = {
+			title = "Metadata"
+			description = "Free form metadata"
+		}
+	}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint_property.metadata", "object_prop.title", "Metadata"),
+					resource.TestCheckResourceAttr("port_blueprint_property.metadata", "object_prop.description", "Free form metadata"),
+				),
+			},
+			{
+				ResourceName:      "port_blueprint_property.metadata",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s:metadata", blueprintIdentifier),
+			},
+		},
+	})
+}
+
+func TestAccPortBlueprintPropertyMovedFromBlueprint(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	var testAccEntityConfig = `
+	resource "port_entity" "checkout" {
+		identifier = "checkout"
+		title = "Checkout"
+		blueprint = port_blueprint.microservice.identifier
+		properties = {
+			string_props = {
+				"language" = "go"
+			}
+		}
+	}
+`
+	var testAccConfigMoved = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "Microservice"
+		icon = "Terraform"
+		identifier = "%s"
+		description = ""
+		ignore_external_properties = true
+	}
+
+	resource "port_blueprint_property" "language" {
+		blueprint_identifier = port_blueprint.microservice.identifier
+		property_identifier = "language"
+		string_prop = {
+			title = "Language"
+		}
+	}
+`, blueprintIdentifier) + testAccEntityConfig
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + baseBlueprintTemplate(blueprintIdentifier) + testAccEntityConfig,
+				Check:  resource.TestCheckResourceAttr("port_entity.checkout", "properties.string_props.language", "go"),
+			},
+			{
+				// the property removed from port_blueprint is deleted from the blueprint before port_blueprint_property
+				// creates it again, so the values of the entities would be lost
+				Config:      acctest.ProviderConfig + testAccConfigMoved,
+				ExpectError: regexp.MustCompile(`property language was removed \(1 entities hold a value for it\)`),
+			},
+		},
+	})
+}
@@ -0,0 +1,128 @@
+package blueprint_property
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint"
+)
+
+func BlueprintPropertySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"blueprint_identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the blueprint the property will be added to",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"property_identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the property",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"string_prop": schema.SingleNestedAttribute{
+			MarkdownDescription: "The string property",
+			Optional:            true,
+			Attributes:          blueprint.StringPropertyAttributes(),
+			Validators: []validator.Object{
+				objectvalidator.ExactlyOneOf(
+					path.MatchRoot("string_prop"),
+					path.MatchRoot("number_prop"),
+					path.MatchRoot("boolean_prop"),
+					path.MatchRoot("array_prop"),
+					path.MatchRoot("object_prop"),
+				),
+			},
+		},
+		"number_prop": schema.SingleNestedAttribute{
+			MarkdownDescription: "The number property",
+			Optional:            true,
+			Attributes:          blueprint.NumberPropertyAttributes(),
+		},
+		"boolean_prop": schema.SingleNestedAttribute{
+			MarkdownDescription: "The boolean property",
+			Optional:            true,
+			Attributes:          blueprint.BooleanPropertyAttributes(),
+		},
+		"array_prop": schema.SingleNestedAttribute{
+			MarkdownDescription: "The array property",
+			Optional:            true,
+			Attributes:          blueprint.ArrayPropertyAttributes(),
+		},
+		"object_prop": schema.SingleNestedAttribute{
+			MarkdownDescription: "The object property",
+			Optional:            true,
+			Attributes:          blueprint.ObjectPropertyAttributes(),
+		},
+	}
+}
+
+func (r *BlueprintPropertyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: BlueprintPropertyResourceMarkdownDescription,
+		Attributes:          BlueprintPropertySchema(),
+	}
+}
+
+var BlueprintPropertyResourceMarkdownDescription = `
+
+# Blueprint Property
+
+This resource allows you to manage a single property of an existing blueprint, so different teams can add their own properties to a shared blueprint.
+
+Exactly one of ` + "`string_prop`, `number_prop`, `boolean_prop`, `array_prop` or `object_prop`" + ` should be set.
+
+The blueprint the property is added to should set ` + "`ignore_external_properties = true`" + `, otherwise the ` + "`port_blueprint`" + ` resource will remove the property on its next apply.
+
+## Example Usage
+
+` + "```hcl" + `
+
+resource "port_blueprint" "service" {
+  title                      = "Service"
+  icon                       = "Microservice"
+  identifier                 = "service"
+  ignore_external_properties = true
+  properties = {
+    string_props = {
+      "language" = {
+        title = "Language"
+      }
+    }
+  }
+}
+
+resource "port_blueprint_property" "tier" {
+  blueprint_identifier = port_blueprint.service.identifier
+  property_identifier  = "tier"
+  string_prop = {
+    title    = "Tier"
+    required = true
+    enum     = ["gold", "silver", "bronze"]
+  }
+}
+
+resource "port_blueprint_property" "replicas" {
+  blueprint_identifier = port_blueprint.service.identifier
+  property_identifier  = "replicas"
+  number_prop = {
+    title   = "Replicas"
+    minimum = 1
+  }
+}
+
+` + "```" + `
+
+`
@@ -0,0 +1,16 @@
+package blueprint_relation
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BlueprintRelationModel struct {
+	ID                  types.String `tfsdk:"id"`
+	BlueprintIdentifier types.String `tfsdk:"blueprint_identifier"`
+	RelationIdentifier  types.String `tfsdk:"relation_identifier"`
+	Target              types.String `tfsdk:"target"`
+	Title               types.String `tfsdk:"title"`
+	Description         types.String `tfsdk:"description"`
+	Required            types.Bool   `tfsdk:"required"`
+	Many                types.Bool   `tfsdk:"many"`
+}
@@ -0,0 +1,51 @@
+package blueprint_relation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/stateupgrade"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint"
+	"github.com/samber/lo"
+)
+
+func (r *BlueprintRelationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		stateupgrade.Mover("port_blueprint", moveStateFromBlueprint),
+	}
+}
+
+// moveStateFromBlueprint moves the relation of a port_blueprint that only manages a single relation. A moved block
+// can't split a resource, so the blueprint itself leaves the state and should be imported again, to another address
+// and with ignore_external_relations set, blueprints with several relations are rejected
+func moveStateFromBlueprint(source stateupgrade.State, sourceSchemaVersion int64) (stateupgrade.State, error) {
+	if err := blueprint.UpgradeJSONState(source, sourceSchemaVersion); err != nil {
+		return nil, err
+	}
+	blueprintIdentifier := stateupgrade.String(source, "identifier")
+	relations := stateupgrade.Object(source, "relations")
+	if len(relations) != 1 {
+		relationIdentifiers := lo.Keys(relations)
+		sort.Strings(relationIdentifiers)
+		return nil, fmt.Errorf("only a blueprint with a single relation can be moved to a port_blueprint_relation, the blueprint %s has %d relations (%s). Remove the moved block, remove the relations from the blueprint, set its ignore_external_relations to true and import each relation with the ID %s:<relation_identifier> instead", blueprintIdentifier, len(relations), strings.Join(relationIdentifiers, ", "), blueprintIdentifier)
+	}
+
+	var state stateupgrade.State
+	for relationIdentifier, r := range relations {
+		relation, _ := r.(map[string]any)
+		state = stateupgrade.State{
+			"id":                   fmt.Sprintf("%s:%s", blueprintIdentifier, relationIdentifier),
+			"blueprint_identifier": blueprintIdentifier,
+			"relation_identifier":  relationIdentifier,
+			"target":               relation["target"],
+			"title":                relation["title"],
+			"description":          relation["description"],
+			"many":                 relation["many"],
+			"required":             relation["required"],
+		}
+	}
+	return state, nil
+}
@@ -0,0 +1,16 @@
+package blueprint_relation_test
+
+import (
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint-relation"
+)
+
+func TestMoveStateFromBlueprint(t *testing.T) {
+	acctest.CheckStateMove(t, &blueprint_relation.BlueprintRelationResource{}, "port_blueprint", 1, "testdata/port_blueprint_state.json", "testdata/state.json")
+}
+
+func TestMoveStateFromBlueprintV0(t *testing.T) {
+	acctest.CheckStateMove(t, &blueprint_relation.BlueprintRelationResource{}, "port_blueprint", 0, "testdata/port_blueprint_v0_state.json", "testdata/state.json")
+}
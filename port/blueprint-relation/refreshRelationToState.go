@@ -0,0 +1,22 @@
+package blueprint_relation
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint"
+)
+
+func refreshRelationState(state *BlueprintRelationModel, b *cli.Blueprint) {
+	identifier := state.RelationIdentifier.ValueString()
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s", b.Identifier, identifier))
+	state.BlueprintIdentifier = types.StringValue(b.Identifier)
+
+	relation := blueprint.RelationToState(b.Relations[identifier])
+	state.Target = relation.Target
+	state.Title = relation.Title
+	state.Description = relation.Description
+	state.Required = relation.Required
+	state.Many = relation.Many
+}
@@ -0,0 +1,16 @@
+package blueprint_relation
+
+import (
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint"
+)
+
+func relationToPortBody(state *BlueprintRelationModel) cli.Relation {
+	return blueprint.RelationToBody(blueprint.RelationModel{
+		Target:      state.Target,
+		Title:       state.Title,
+		Description: state.Description,
+		Required:    state.Required,
+		Many:        state.Many,
+	})
+}
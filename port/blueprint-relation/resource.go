@@ -0,0 +1,212 @@
+package blueprint_relation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+var _ resource.Resource = &BlueprintRelationResource{}
+var _ resource.ResourceWithImportState = &BlueprintRelationResource{}
+var _ resource.ResourceWithModifyPlan = &BlueprintRelationResource{}
+var _ resource.ResourceWithMoveState = &BlueprintRelationResource{}
+
+func NewBlueprintRelationResource() resource.Resource {
+	return &BlueprintRelationResource{}
+}
+
+type BlueprintRelationResource struct {
+	portClient *cli.PortClient
+}
+
+func (r *BlueprintRelationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprint_relation"
+}
+
+func (r *BlueprintRelationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+// ModifyPlan records the blueprint as changed, the plan validations of the resources that use it don't fail on the
+// parts of it that are only changed by the same apply
+func (r *BlueprintRelationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.portClient == nil {
+		return
+	}
+	r.portClient.PendingBlueprints.AddPlannedChange(ctx, req, path.Root("blueprint_identifier"))
+}
+
+func (r *BlueprintRelationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importid.Import(ctx, req, resp, "blueprint_identifier", "relation_identifier")
+}
+
+func (r *BlueprintRelationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *BlueprintRelationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	b, statusCode, err := r.portClient.ReadBlueprint(ctx, state.BlueprintIdentifier.ValueString())
+	if err != nil {
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading blueprint", err.Error())
+		return
+	}
+
+	if _, ok := b.Relations[state.RelationIdentifier.ValueString()]; !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	refreshRelationState(state, b)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BlueprintRelationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state *BlueprintRelationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	relation := relationToPortBody(state)
+
+	blueprintIdentifier := state.BlueprintIdentifier.ValueString()
+	relationIdentifier := state.RelationIdentifier.ValueString()
+
+	unlock := utils.BlueprintMutex.Lock(blueprintIdentifier)
+	defer unlock()
+
+	b, statusCode, err := r.portClient.ReadBlueprint(ctx, blueprintIdentifier)
+	if err != nil {
+		if statusCode == 404 {
+			resp.Diagnostics.AddError("Blueprint doesn't exists, it is required to create a blueprint relation", err.Error())
+			return
+		}
+		resp.Diagnostics.AddError("failed reading blueprint", err.Error())
+		return
+	}
+
+	if _, ok := b.Relations[relationIdentifier]; ok {
+		resp.Diagnostics.AddError("blueprint relation already exists", fmt.Sprintf("relation %s already exists in blueprint %s", relationIdentifier, blueprintIdentifier))
+		return
+	}
+
+	if b.Relations == nil {
+		b.Relations = map[string]cli.Relation{}
+	}
+	b.Relations[relationIdentifier] = relation
+
+	_, err = r.portClient.UpdateBlueprint(ctx, b, blueprintIdentifier)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create blueprint relation", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s", blueprintIdentifier, relationIdentifier))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BlueprintRelationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state *BlueprintRelationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	relation := relationToPortBody(state)
+
+	blueprintIdentifier := state.BlueprintIdentifier.ValueString()
+	relationIdentifier := state.RelationIdentifier.ValueString()
+
+	unlock := utils.BlueprintMutex.Lock(blueprintIdentifier)
+	defer unlock()
+
+	b, statusCode, err := r.portClient.ReadBlueprint(ctx, blueprintIdentifier)
+	if err != nil {
+		if statusCode == 404 {
+			resp.Diagnostics.AddError("Blueprint doesn't exists, it is required to update a blueprint relation", err.Error())
+			return
+		}
+		resp.Diagnostics.AddError("failed reading blueprint", err.Error())
+		return
+	}
+
+	if b.Relations == nil {
+		b.Relations = map[string]cli.Relation{}
+	}
+	b.Relations[relationIdentifier] = relation
+
+	_, err = r.portClient.UpdateBlueprint(ctx, b, blueprintIdentifier)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update blueprint relation", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s", blueprintIdentifier, relationIdentifier))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BlueprintRelationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *BlueprintRelationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blueprintIdentifier := state.BlueprintIdentifier.ValueString()
+	relationIdentifier := state.RelationIdentifier.ValueString()
+
+	unlock := utils.BlueprintMutex.Lock(blueprintIdentifier)
+	defer unlock()
+
+	b, statusCode, err := r.portClient.ReadBlueprint(ctx, blueprintIdentifier)
+	if err != nil {
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading blueprint", err.Error())
+		return
+	}
+
+	if _, ok := b.Relations[relationIdentifier]; !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	delete(b.Relations, relationIdentifier)
+
+	_, err = r.portClient.UpdateBlueprint(ctx, b, blueprintIdentifier)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete blueprint relation", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,345 @@
+package blueprint_relation_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func baseBlueprintsTemplate(serviceIdentifier string, environmentIdentifier string) string {
+	return fmt.Sprintf(`
+	resource "port_blueprint" "service" {
+		title = "Service"
+		icon = "Terraform"
+		identifier = "%s"
+		description = ""
+		ignore_external_relations = true
+	}
+
+	resource "port_blueprint" "environment" {
+		title = "Environment"
+		icon = "Terraform"
+		identifier = "%s"
+		description = ""
+		ignore_external_relations = true
+	}
+`, serviceIdentifier, environmentIdentifier)
+}
+
+func TestAccPortBlueprintRelationBasic(t *testing.T) {
+	serviceIdentifier := utils.GenID()
+	environmentIdentifier := utils.GenID()
+	var testAccConfigCreate = baseBlueprintsTemplate(serviceIdentifier, environmentIdentifier) + `
+	resource "port_blueprint_relation" "service_environment" {
+		blueprint_identifier = port_blueprint.service.identifier
+		relation_identifier = "environment"
+		target = port_blueprint.environment.identifier
+		title = "Environment"
+	}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "id", fmt.Sprintf("%s:environment", serviceIdentifier)),
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "blueprint_identifier", serviceIdentifier),
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "relation_identifier", "environment"),
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "target", environmentIdentifier),
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "title", "Environment"),
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "many", "false"),
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "required", "false"),
+					resource.TestCheckNoResourceAttr("port_blueprint.service", "relations"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortBlueprintRelationCircular(t *testing.T) {
+	// Test checks that two blueprints can relate to each other without a dependency cycle
+	serviceIdentifier := utils.GenID()
+	environmentIdentifier := utils.GenID()
+	var testAccConfigCreate = baseBlueprintsTemplate(serviceIdentifier, environmentIdentifier) + `
+	resource "port_blueprint_relation" "service_environment" {
+		blueprint_identifier = port_blueprint.service.identifier
+		relation_identifier = "environment"
+		target = port_blueprint.environment.identifier
+	}
+
+	resource "port_blueprint_relation" "environment_services" {
+		blueprint_identifier = port_blueprint.environment.identifier
+		relation_identifier = "services"
+		target = port_blueprint.service.identifier
+		many = true
+	}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "target", environmentIdentifier),
+					resource.TestCheckResourceAttr("port_blueprint_relation.environment_services", "target", serviceIdentifier),
+					resource.TestCheckResourceAttr("port_blueprint_relation.environment_services", "many", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortBlueprintRelationUpdate(t *testing.T) {
+	serviceIdentifier := utils.GenID()
+	environmentIdentifier := utils.GenID()
+	var testAccConfigCreate = baseBlueprintsTemplate(serviceIdentifier, environmentIdentifier) + `
+	resource "port_blueprint_relation" "service_environment" {
+		blueprint_identifier = port_blueprint.service.identifier
+		relation_identifier = "environment"
+		target = port_blueprint.environment.identifier
+		title = "Environment"
+	}
+`
+
+	var testAccConfigUpdate = baseBlueprintsTemplate(serviceIdentifier, environmentIdentifier) + `
+	resource "port_blueprint_relation" "service_environment" {
+		blueprint_identifier = port_blueprint.service.identifier
+		relation_identifier = "environment"
+		target = port_blueprint.environment.identifier
+		title = "Environments"
+		description = "The environments of the service"
+		required = true
+		many = true
+	}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "title", "Environment"),
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "many", "false"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "title", "Environments"),
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "description", "The environments of the service"),
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "required", "true"),
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "many", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortBlueprintRelationImport(t *testing.T) {
+	serviceIdentifier := utils.GenID()
+	environmentIdentifier := utils.GenID()
+	var testAccConfigCreate = baseBlueprintsTemplate(serviceIdentifier, environmentIdentifier) + `
+	resource "port_blueprint_relation" "service_environment" {
+		blueprint_identifier = port_blueprint.service.identifier
+		relation_identifier = "environment"
+		target = port_blueprint.environment.identifier
+		title = "Environment"
+	}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "title", "Environment"),
+				),
+			},
+			{
+				ResourceName:      "port_blueprint_relation.service_environment",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s:environment", serviceIdentifier),
+			},
+		},
+	})
+}
+
+func TestAccPortBlueprintRelationMovedFromBlueprint(t *testing.T) {
+	serviceIdentifier := utils.GenID()
+	environmentIdentifier := utils.GenID()
+	var testAccConfigBlueprint = fmt.Sprintf(`
+	resource "port_blueprint" "environment" {
+		title = "Environment"
+		icon = "Terraform"
+		identifier = "%s"
+		description = ""
+	}
+
+	resource "port_blueprint" "service" {
+		title = "Service"
+		icon = "Terraform"
+		identifier = "%s"
+		description = ""
+		relations = {
+			"environment" = {
+				title = "Environment"
+				target = port_blueprint.environment.identifier
+				required = true
+			}
+		}
+	}
+`, environmentIdentifier, serviceIdentifier)
+
+	var testAccConfigMoved = fmt.Sprintf(`
+	resource "port_blueprint" "environment" {
+		title = "Environment"
+		icon = "Terraform"
+		identifier = "%s"
+		description = ""
+	}
+
+	moved {
+		from = port_blueprint.service
+		to   = port_blueprint_relation.service_environment
+	}
+
+	import {
+		to = port_blueprint.service_blueprint
+		id = "%s"
+	}
+
+	resource "port_blueprint" "service_blueprint" {
+		title = "Service"
+		icon = "Terraform"
+		identifier = "%s"
+		description = ""
+		ignore_external_relations = true
+	}
+
+	resource "port_blueprint_relation" "service_environment" {
+		blueprint_identifier = port_blueprint.service_blueprint.identifier
+		relation_identifier = "environment"
+		target = port_blueprint.environment.identifier
+		title = "Environment"
+		required = true
+	}
+`, environmentIdentifier, serviceIdentifier, serviceIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccConfigBlueprint,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint.service", "relations.environment.target", environmentIdentifier),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccConfigMoved,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "id", fmt.Sprintf("%s:environment", serviceIdentifier)),
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "target", environmentIdentifier),
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "title", "Environment"),
+					resource.TestCheckResourceAttr("port_blueprint_relation.service_environment", "required", "true"),
+					resource.TestCheckResourceAttr("port_blueprint.service_blueprint", "identifier", serviceIdentifier),
+					resource.TestCheckNoResourceAttr("port_blueprint.service_blueprint", "relations"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortBlueprintRelationMovedFromBlueprintWithSeveralRelations(t *testing.T) {
+	serviceIdentifier := utils.GenID()
+	environmentIdentifier := utils.GenID()
+	var testAccConfigBlueprint = fmt.Sprintf(`
+	resource "port_blueprint" "environment" {
+		title = "Environment"
+		icon = "Terraform"
+		identifier = "%s"
+		description = ""
+	}
+
+	resource "port_blueprint" "service" {
+		title = "Service"
+		icon = "Terraform"
+		identifier = "%s"
+		description = ""
+		relations = {
+			"environment" = {
+				title = "Environment"
+				target = port_blueprint.environment.identifier
+			}
+			"dependencies" = {
+				title = "Dependencies"
+				target = port_blueprint.environment.identifier
+				many = true
+			}
+		}
+	}
+`, environmentIdentifier, serviceIdentifier)
+
+	var testAccConfigMoved = fmt.Sprintf(`
+	resource "port_blueprint" "environment" {
+		title = "Environment"
+		icon = "Terraform"
+		identifier = "%s"
+		description = ""
+	}
+
+	moved {
+		from = port_blueprint.service
+		to   = port_blueprint_relation.service_environment
+	}
+
+	resource "port_blueprint_relation" "service_environment" {
+		blueprint_identifier = "%s"
+		relation_identifier = "environment"
+		target = port_blueprint.environment.identifier
+		title = "Environment"
+	}
+`, environmentIdentifier, serviceIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccConfigBlueprint,
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccConfigMoved,
+				ExpectError: regexp.MustCompile(`(?s)only a blueprint with a single relation can be moved.*has 2 relations.*\(dependencies,.*environment\)`),
+			},
+			{
+				// the state is left as it was, the blueprint can be destroyed once the moved block is removed
+				Config: acctest.ProviderConfig + testAccConfigBlueprint,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint.service", "relations.%", "2"),
+				),
+			},
+		},
+	})
+}
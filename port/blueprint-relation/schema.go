@@ -0,0 +1,142 @@
+package blueprint_relation
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func BlueprintRelationSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"blueprint_identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the blueprint the relation will be added to",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"relation_identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the relation",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"target": schema.StringAttribute{
+			MarkdownDescription: "The target of the relation",
+			Required:            true,
+		},
+		"title": schema.StringAttribute{
+			MarkdownDescription: "The title of the relation",
+			Optional:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the relation",
+			Optional:            true,
+		},
+		"many": schema.BoolAttribute{
+			MarkdownDescription: "The many of the relation",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"required": schema.BoolAttribute{
+			MarkdownDescription: "The required of the relation",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+}
+
+func (r *BlueprintRelationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: BlueprintRelationResourceMarkdownDescription,
+		Attributes:          BlueprintRelationSchema(),
+	}
+}
+
+var BlueprintRelationResourceMarkdownDescription = `
+
+# Blueprint Relation
+
+This resource allows you to manage a single relation of an existing blueprint, it is useful when two blueprints relate to each other, or when different teams own the relations of a shared blueprint.
+
+The blueprint the relation is added to should set ` + "`ignore_external_relations = true`" + `, otherwise the ` + "`port_blueprint`" + ` resource will remove the relation on its next apply.
+
+## Example Usage
+
+` + "```hcl" + `
+
+resource "port_blueprint" "service" {
+  title                     = "Service"
+  icon                      = "Microservice"
+  identifier                = "service"
+  ignore_external_relations = true
+}
+
+resource "port_blueprint" "environment" {
+  title                     = "Environment"
+  icon                      = "Environment"
+  identifier                = "environment"
+  ignore_external_relations = true
+}
+
+resource "port_blueprint_relation" "service_environment" {
+  blueprint_identifier = port_blueprint.service.identifier
+  relation_identifier  = "environment"
+  target               = port_blueprint.environment.identifier
+  title                = "Environment"
+  required             = true
+}
+
+resource "port_blueprint_relation" "environment_services" {
+  blueprint_identifier = port_blueprint.environment.identifier
+  relation_identifier  = "services"
+  target               = port_blueprint.service.identifier
+  title                = "Services"
+  many                 = true
+}
+
+` + "```" + `
+
+## Moving a Relation out of a Blueprint
+
+A ` + "`port_blueprint`" + ` that only defines a single relation can be moved to a ` + "`port_blueprint_relation`" + ` without recreating the relation, requires Terraform 1.8 or later.
+A ` + "`moved`" + ` block can't split a resource, so the blueprint itself leaves the state: declare it under a new address, without its relations and with ` + "`ignore_external_relations = true`" + `, and import it in the same apply:
+
+` + "```hcl" + `
+moved {
+  from = port_blueprint.service
+  to   = port_blueprint_relation.service_environment
+}
+
+import {
+  to = port_blueprint.service_blueprint
+  id = "service"
+}
+
+resource "port_blueprint" "service_blueprint" {
+  identifier                = "service"
+  title                     = "Service"
+  icon                      = "Microservice"
+  ignore_external_relations = true
+}
+
+resource "port_blueprint_relation" "service_environment" {
+  blueprint_identifier = port_blueprint.service_blueprint.identifier
+  relation_identifier  = "environment"
+  target               = port_blueprint.environment.identifier
+  title                = "Environment"
+}
+` + "```" + `
+
+Blueprints with several relations can't be moved and the plan fails, remove the relations from the blueprint, set ` + "`ignore_external_relations = true`" + ` and import each relation with the ID ` + "`<blueprint_identifier>:<relation_identifier>`" + ` instead.
+`
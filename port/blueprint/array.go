@@ -12,8 +12,8 @@ import (
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
-func arrayPropResourceToBody(ctx context.Context, state *BlueprintModel, props map[string]cli.BlueprintProperty, required *[]string) error {
-	for propIdentifier, prop := range state.Properties.ArrayProps {
+func arrayPropResourceToBody(ctx context.Context, properties *PropertiesModel, props map[string]cli.BlueprintProperty, required *[]string) error {
+	for propIdentifier, prop := range properties.ArrayProps {
 		props[propIdentifier] = cli.BlueprintProperty{
 			Type: "array",
 		}
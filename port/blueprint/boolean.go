@@ -2,8 +2,8 @@ package blueprint
 
 import "github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 
-func booleanPropResourceToBody(state *BlueprintModel, props map[string]cli.BlueprintProperty, required *[]string) {
-	for propIdentifier, prop := range state.Properties.BooleanProps {
+func booleanPropResourceToBody(properties *PropertiesModel, props map[string]cli.BlueprintProperty, required *[]string) {
+	for propIdentifier, prop := range properties.BooleanProps {
 		props[propIdentifier] = cli.BlueprintProperty{
 			Type: "boolean",
 		}
@@ -0,0 +1,132 @@
+package blueprint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+// unknownFieldType marks fields whose type is only known after apply, they can't be compared at plan time
+const unknownFieldType = "(known after apply)"
+
+type blueprintFieldChange struct {
+	Kind       string
+	Identifier string
+	Change     string
+	Entities   int
+}
+
+func (c blueprintFieldChange) String() string {
+	return fmt.Sprintf("%s %s was %s (%d entities hold a value for it)", c.Kind, c.Identifier, c.Change, c.Entities)
+}
+
+func propertiesTypes(properties *PropertiesModel) map[string]string {
+	fieldsTypes := make(map[string]string)
+	if properties == nil {
+		return fieldsTypes
+	}
+	for identifier := range properties.StringProps {
+		fieldsTypes[identifier] = "string"
+	}
+	for identifier := range properties.NumberProps {
+		fieldsTypes[identifier] = "number"
+	}
+	for identifier := range properties.BooleanProps {
+		fieldsTypes[identifier] = "boolean"
+	}
+	for identifier := range properties.ObjectProps {
+		fieldsTypes[identifier] = "object"
+	}
+	for identifier, prop := range properties.ArrayProps {
+		// array without items type is array of string by default
+		itemsType := "string"
+		if prop.NumberItems != nil {
+			itemsType = "number"
+		} else if prop.BooleanItems != nil {
+			itemsType = "boolean"
+		} else if prop.ObjectItems != nil {
+			itemsType = "object"
+		}
+		fieldsTypes[identifier] = fmt.Sprintf("array of %s", itemsType)
+	}
+	return fieldsTypes
+}
+
+func relationsTypes(relations map[string]RelationModel) map[string]string {
+	fieldsTypes := make(map[string]string)
+	for identifier, relation := range relations {
+		if relation.Target.IsUnknown() || relation.Many.IsUnknown() {
+			fieldsTypes[identifier] = unknownFieldType
+			continue
+		}
+		if relation.Many.ValueBool() {
+			fieldsTypes[identifier] = fmt.Sprintf("many relation to %s", relation.Target.ValueString())
+		} else {
+			fieldsTypes[identifier] = fmt.Sprintf("relation to %s", relation.Target.ValueString())
+		}
+	}
+	return fieldsTypes
+}
+
+func mirrorPropertiesTypes(mirrorProperties map[string]MirrorPropertyModel) map[string]string {
+	fieldsTypes := make(map[string]string)
+	for identifier, mirrorProperty := range mirrorProperties {
+		if mirrorProperty.Path.IsUnknown() {
+			fieldsTypes[identifier] = unknownFieldType
+			continue
+		}
+		fieldsTypes[identifier] = fmt.Sprintf("mirror of %s", mirrorProperty.Path.ValueString())
+	}
+	return fieldsTypes
+}
+
+func diffFieldsTypes(kind string, prior map[string]string, planned map[string]string) []blueprintFieldChange {
+	var changes []blueprintFieldChange
+	for identifier, priorType := range prior {
+		plannedType, ok := planned[identifier]
+		if !ok {
+			changes = append(changes, blueprintFieldChange{Kind: kind, Identifier: identifier, Change: "removed"})
+			continue
+		}
+		if plannedType != priorType && plannedType != unknownFieldType {
+			changes = append(changes, blueprintFieldChange{Kind: kind, Identifier: identifier, Change: fmt.Sprintf("changed from %s to %s", priorType, plannedType)})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Identifier < changes[j].Identifier
+	})
+	return changes
+}
+
+func destructiveBlueprintChanges(state *BlueprintModel, plan *BlueprintModel) []blueprintFieldChange {
+	var changes []blueprintFieldChange
+	changes = append(changes, diffFieldsTypes("property", propertiesTypes(state.Properties), propertiesTypes(plan.Properties))...)
+	changes = append(changes, diffFieldsTypes("relation", relationsTypes(state.Relations), relationsTypes(plan.Relations))...)
+	changes = append(changes, diffFieldsTypes("mirror property", mirrorPropertiesTypes(state.MirrorProperties), mirrorPropertiesTypes(plan.MirrorProperties))...)
+	return changes
+}
+
+func hasValue(value any) bool {
+	if value == nil {
+		return false
+	}
+	if values, ok := value.([]any); ok {
+		return len(values) > 0
+	}
+	return true
+}
+
+func countEntitiesWithValues(changes []blueprintFieldChange, entities []cli.Entity) {
+	for i, change := range changes {
+		for _, e := range entities {
+			values := e.Properties
+			if change.Kind == "relation" {
+				values = e.Relations
+			}
+			if hasValue(values[change.Identifier]) {
+				changes[i].Entities++
+			}
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package blueprint
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+func TestDestructiveBlueprintChangesIgnoreExternal(t *testing.T) {
+	state := &BlueprintModel{
+		Properties: &PropertiesModel{StringProps: map[string]StringPropModel{"owner": {}, "language": {}}},
+		Relations:  map[string]RelationModel{"environment": {Target: types.StringValue("environment"), Many: types.BoolValue(false)}},
+	}
+	plan := func(ignoreExternal bool) *BlueprintModel {
+		return &BlueprintModel{
+			// the owner property is removed and the language property changes its type
+			Properties:               &PropertiesModel{NumberProps: map[string]NumberPropModel{"language": {}}},
+			IgnoreExternalProperties: types.BoolValue(ignoreExternal),
+			IgnoreExternalRelations:  types.BoolValue(ignoreExternal),
+		}
+	}
+	identifiers := func(changes []blueprintFieldChange) []string {
+		return lo.Map(changes, func(c blueprintFieldChange, _ int) string { return c.Kind + " " + c.Identifier + " " + c.Change })
+	}
+
+	// the properties and relations removed from the resource are deleted from the blueprint even when the external
+	// ones are ignored, as they were managed by the resource
+	expected := []string{"property language changed from string to number", "property owner removed", "relation environment removed"}
+	for _, ignoreExternal := range []bool{false, true} {
+		got := identifiers(destructiveBlueprintChanges(state, plan(ignoreExternal)))
+		if !lo.Every(got, expected) || len(got) != len(expected) {
+			t.Errorf("expected %v when ignoring external fields is %t, got %v", expected, ignoreExternal, got)
+		}
+	}
+}
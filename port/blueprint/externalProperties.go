@@ -0,0 +1,101 @@
+package blueprint
+
+import (
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/samber/lo"
+)
+
+func propertiesIdentifiers(properties *PropertiesModel) map[string]bool {
+	identifiers := map[string]bool{}
+	if properties == nil {
+		return identifiers
+	}
+
+	for k := range properties.StringProps {
+		identifiers[k] = true
+	}
+	for k := range properties.NumberProps {
+		identifiers[k] = true
+	}
+	for k := range properties.BooleanProps {
+		identifiers[k] = true
+	}
+	for k := range properties.ArrayProps {
+		identifiers[k] = true
+	}
+	for k := range properties.ObjectProps {
+		identifiers[k] = true
+	}
+
+	return identifiers
+}
+
+func filterProperties(properties *PropertiesModel, identifiers map[string]bool) *PropertiesModel {
+	if len(identifiers) == 0 {
+		return nil
+	}
+
+	filtered := &PropertiesModel{}
+
+	for k, v := range properties.StringProps {
+		if identifiers[k] {
+			if filtered.StringProps == nil {
+				filtered.StringProps = make(map[string]StringPropModel)
+			}
+			filtered.StringProps[k] = v
+		}
+	}
+	for k, v := range properties.NumberProps {
+		if identifiers[k] {
+			if filtered.NumberProps == nil {
+				filtered.NumberProps = make(map[string]NumberPropModel)
+			}
+			filtered.NumberProps[k] = v
+		}
+	}
+	for k, v := range properties.BooleanProps {
+		if identifiers[k] {
+			if filtered.BooleanProps == nil {
+				filtered.BooleanProps = make(map[string]BooleanPropModel)
+			}
+			filtered.BooleanProps[k] = v
+		}
+	}
+	for k, v := range properties.ArrayProps {
+		if identifiers[k] {
+			if filtered.ArrayProps == nil {
+				filtered.ArrayProps = make(map[string]ArrayPropModel)
+			}
+			filtered.ArrayProps[k] = v
+		}
+	}
+	for k, v := range properties.ObjectProps {
+		if identifiers[k] {
+			if filtered.ObjectProps == nil {
+				filtered.ObjectProps = make(map[string]ObjectPropModel)
+			}
+			filtered.ObjectProps[k] = v
+		}
+	}
+
+	return filtered
+}
+
+// keepExternalProperties copies the properties of the existing blueprint that aren't part of the request and weren't
+// managed by the resource before, as those are managed by other resources
+func keepExternalProperties(b *cli.Blueprint, existingBp *cli.Blueprint, managedProperties map[string]bool) {
+	for k, v := range existingBp.Schema.Properties {
+		if _, ok := b.Schema.Properties[k]; ok || managedProperties[k] {
+			continue
+		}
+
+		if b.Schema.Properties == nil {
+			b.Schema.Properties = map[string]cli.BlueprintProperty{}
+		}
+		b.Schema.Properties[k] = v
+
+		if lo.Contains(existingBp.Schema.Required, k) {
+			b.Schema.Required = append(b.Schema.Required, k)
+		}
+	}
+}
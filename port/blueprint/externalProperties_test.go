@@ -0,0 +1,50 @@
+package blueprint
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/samber/lo"
+)
+
+func TestKeepExternalPropertiesAndRelations(t *testing.T) {
+	existingBp := &cli.Blueprint{
+		Schema: cli.BlueprintSchema{
+			Properties: map[string]cli.BlueprintProperty{"language": {Type: "string"}, "owner": {Type: "string"}, "tier": {Type: "string"}},
+			Required:   []string{"tier"},
+		},
+		Relations: map[string]cli.Relation{"environment": {Target: lo.ToPtr("environment")}, "domain": {Target: lo.ToPtr("domain")}},
+	}
+	b := &cli.Blueprint{
+		Schema: cli.BlueprintSchema{Properties: map[string]cli.BlueprintProperty{"language": {Type: "number"}}},
+	}
+
+	// owner and environment were managed by the resource and are removed from it, tier and domain are managed elsewhere
+	keepExternalProperties(b, existingBp, map[string]bool{"language": true, "owner": true})
+	keepExternalRelations(b, existingBp, []string{"environment"})
+
+	if properties := lo.Keys(b.Schema.Properties); len(properties) != 2 || !lo.Every(properties, []string{"language", "tier"}) {
+		t.Errorf("expected the properties language and tier, got %v", properties)
+	}
+	if b.Schema.Properties["language"].Type != "number" {
+		t.Errorf("expected the property of the request to be kept, got %v", b.Schema.Properties["language"])
+	}
+	if len(b.Schema.Required) != 1 || b.Schema.Required[0] != "tier" {
+		t.Errorf("expected the external property to stay required, got %v", b.Schema.Required)
+	}
+	if relations := lo.Keys(b.Relations); len(relations) != 1 || relations[0] != "domain" {
+		t.Errorf("expected the relation domain, got %v", relations)
+	}
+}
+
+func TestManagedRelations(t *testing.T) {
+	relations := map[string]RelationModel{"environment": {}}
+	// an imported blueprint holds every relation without ignore_external_relations, none of them were managed by it
+	if managed := managedRelations(&BlueprintModel{IgnoreExternalRelations: types.BoolValue(false), Relations: relations}); len(managed) != 0 {
+		t.Errorf("expected no managed relations, got %v", managed)
+	}
+	if managed := managedRelations(&BlueprintModel{IgnoreExternalRelations: types.BoolValue(true), Relations: relations}); len(managed) != 1 || managed[0] != "environment" {
+		t.Errorf("expected the relation environment, got %v", managed)
+	}
+}
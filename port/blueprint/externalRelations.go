@@ -0,0 +1,39 @@
+package blueprint
+
+import (
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/samber/lo"
+)
+
+func filterRelations(relations map[string]RelationModel, identifiers []string) map[string]RelationModel {
+	filtered := lo.PickByKeys(relations, identifiers)
+	if len(filtered) == 0 {
+		return nil
+	}
+	return filtered
+}
+
+// managedRelations returns the relations the resource managed in its previous state. Without ignore_external_relations
+// the state holds every relation of the blueprint, e.g. right after an import, so none of them are known to be managed
+// by the resource rather than by port_blueprint_relation resources
+func managedRelations(previousState *BlueprintModel) []string {
+	if !previousState.IgnoreExternalRelations.ValueBool() {
+		return nil
+	}
+	return lo.Keys(previousState.Relations)
+}
+
+// keepExternalRelations copies the relations of the existing blueprint that aren't part of the request and weren't
+// managed by the resource before, as those are managed by other resources
+func keepExternalRelations(b *cli.Blueprint, existingBp *cli.Blueprint, managedRelations []string) {
+	for k, v := range existingBp.Relations {
+		if _, ok := b.Relations[k]; ok || lo.Contains(managedRelations, k) {
+			continue
+		}
+
+		if b.Relations == nil {
+			b.Relations = map[string]cli.Relation{}
+		}
+		b.Relations[k] = v
+	}
+}
@@ -2,6 +2,7 @@ package blueprint
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 )
 
 type WebhookChangelogDestinationModel struct {
@@ -85,12 +86,12 @@ type ArrayPropModel struct {
 }
 
 type ObjectPropModel struct {
-	Title       types.String `tfsdk:"title"`
-	Icon        types.String `tfsdk:"icon"`
-	Description types.String `tfsdk:"description"`
-	Required    types.Bool   `tfsdk:"required"`
-	Default     types.String `tfsdk:"default"`
-	Spec        types.String `tfsdk:"spec"`
+	Title       types.String         `tfsdk:"title"`
+	Icon        types.String         `tfsdk:"icon"`
+	Description types.String         `tfsdk:"description"`
+	Required    types.Bool           `tfsdk:"required"`
+	Default     jsontypes.Normalized `tfsdk:"default"`
+	Spec        types.String         `tfsdk:"spec"`
 }
 
 type PropertiesModel struct {
@@ -102,11 +103,11 @@ type PropertiesModel struct {
 }
 
 type RelationModel struct {
-	Target   	types.String `tfsdk:"target"`
-	Title    	types.String `tfsdk:"title"`
-	Description 	types.String `tfsdk:"description"`
-	Required 	types.Bool   `tfsdk:"required"`
-	Many     	types.Bool   `tfsdk:"many"`
+	Target      types.String `tfsdk:"target"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Required    types.Bool   `tfsdk:"required"`
+	Many        types.Bool   `tfsdk:"many"`
 }
 
 type MirrorPropertyModel struct {
@@ -175,5 +176,8 @@ type BlueprintModel struct {
 	MirrorProperties            map[string]MirrorPropertyModel      `tfsdk:"mirror_properties"`
 	CalculationProperties       map[string]CalculationPropertyModel `tfsdk:"calculation_properties"`
 	ForceDeleteEntities         types.Bool                          `tfsdk:"force_delete_entities"`
+	AllowDataLoss               types.Bool                          `tfsdk:"allow_data_loss"`
+	IgnoreExternalProperties    types.Bool                          `tfsdk:"ignore_external_properties"`
+	IgnoreExternalRelations     types.Bool                          `tfsdk:"ignore_external_relations"`
 	CreateCatalogPage           types.Bool                          `tfsdk:"create_catalog_page"`
 }
@@ -0,0 +1,29 @@
+package blueprint
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/stateupgrade"
+)
+
+// legacyTypeName is the type of the blueprints of the 0.x providers, their states are in the layout upgradeStateV0
+// upgrades
+const legacyTypeName = "port-labs_blueprint"
+
+func (r *BlueprintResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		stateupgrade.Mover(legacyTypeName, func(source stateupgrade.State, sourceSchemaVersion int64) (stateupgrade.State, error) {
+			return source, upgradeStateV0(source)
+		}),
+	}
+}
+
+// UpgradeJSONState upgrades the JSON state of a blueprint stored with schemaVersion to the current layout, for the
+// resources that move parts of blueprints into their own state
+func UpgradeJSONState(state stateupgrade.State, schemaVersion int64) error {
+	if schemaVersion < 1 {
+		return upgradeStateV0(state)
+	}
+	return nil
+}
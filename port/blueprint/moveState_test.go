@@ -0,0 +1,12 @@
+package blueprint_test
+
+import (
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint"
+)
+
+func TestMoveStateFromLegacyBlueprint(t *testing.T) {
+	acctest.CheckStateMove(t, &blueprint.BlueprintResource{}, "port-labs_blueprint", 0, "testdata/state_v0_blocks.json", "testdata/state_v1_blocks.json")
+}
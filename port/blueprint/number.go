@@ -11,8 +11,8 @@ import (
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
-func numberPropResourceToBody(ctx context.Context, state *BlueprintModel, props map[string]cli.BlueprintProperty, required *[]string) error {
-	for propIdentifier, prop := range state.Properties.NumberProps {
+func numberPropResourceToBody(ctx context.Context, properties *PropertiesModel, props map[string]cli.BlueprintProperty, required *[]string) error {
+	for propIdentifier, prop := range properties.NumberProps {
 		props[propIdentifier] = cli.BlueprintProperty{
 			Type: "number",
 		}
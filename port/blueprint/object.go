@@ -8,8 +8,8 @@ import (
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 )
 
-func objectPropResourceToBody(state *BlueprintModel, props map[string]cli.BlueprintProperty, required *[]string) {
-	for propIdentifier, prop := range state.Properties.ObjectProps {
+func objectPropResourceToBody(properties *PropertiesModel, props map[string]cli.BlueprintProperty, required *[]string) {
+	for propIdentifier, prop := range properties.ObjectProps {
 		props[propIdentifier] = cli.BlueprintProperty{
 			Type: "object",
 		}
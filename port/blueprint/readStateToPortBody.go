@@ -7,34 +7,35 @@ import (
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 )
 
-func propsResourceToBody(ctx context.Context, state *BlueprintModel) (map[string]cli.BlueprintProperty, []string, error) {
+// PropertiesToBody converts the typed properties model into Port's schema properties and the list of required ones
+func PropertiesToBody(ctx context.Context, properties *PropertiesModel) (map[string]cli.BlueprintProperty, []string, error) {
 	props := map[string]cli.BlueprintProperty{}
 	var required []string
-	if state.Properties != nil {
-		if state.Properties.StringProps != nil {
-			err := stringPropResourceToBody(ctx, state, props, &required)
+	if properties != nil {
+		if properties.StringProps != nil {
+			err := stringPropResourceToBody(ctx, properties, props, &required)
 			if err != nil {
 				return nil, nil, err
 			}
 		}
-		if state.Properties.ArrayProps != nil {
-			err := arrayPropResourceToBody(ctx, state, props, &required)
+		if properties.ArrayProps != nil {
+			err := arrayPropResourceToBody(ctx, properties, props, &required)
 			if err != nil {
 				return nil, nil, err
 			}
 		}
-		if state.Properties.NumberProps != nil {
-			err := numberPropResourceToBody(ctx, state, props, &required)
+		if properties.NumberProps != nil {
+			err := numberPropResourceToBody(ctx, properties, props, &required)
 			if err != nil {
 				return nil, nil, err
 			}
 		}
-		if state.Properties.BooleanProps != nil {
-			booleanPropResourceToBody(state, props, &required)
+		if properties.BooleanProps != nil {
+			booleanPropResourceToBody(properties, props, &required)
 		}
 
-		if state.Properties.ObjectProps != nil {
-			objectPropResourceToBody(state, props, &required)
+		if properties.ObjectProps != nil {
+			objectPropResourceToBody(properties, props, &required)
 		}
 
 	}
@@ -45,34 +46,39 @@ func relationsResourceToBody(state *BlueprintModel) map[string]cli.Relation {
 	relations := map[string]cli.Relation{}
 
 	for identifier, prop := range state.Relations {
-		target := prop.Target.ValueString()
-		relationProp := cli.Relation{
-			Target: &target,
-		}
+		relations[identifier] = RelationToBody(prop)
+	}
 
-		if !prop.Title.IsNull() {
-			title := prop.Title.ValueString()
-			relationProp.Title = &title
-		}
-		if !prop.Many.IsNull() {
-			many := prop.Many.ValueBool()
-			relationProp.Many = &many
-		}
+	return relations
+}
 
-		if !prop.Required.IsNull() {
-			required := prop.Required.ValueBool()
-			relationProp.Required = &required
-		}
+// RelationToBody converts a single relation model into Port's relation
+func RelationToBody(prop RelationModel) cli.Relation {
+	target := prop.Target.ValueString()
+	relationProp := cli.Relation{
+		Target: &target,
+	}
 
-		if !prop.Description.IsNull() {
-			description := prop.Description.ValueString()
-			relationProp.Description = &description
-		}
+	if !prop.Title.IsNull() {
+		title := prop.Title.ValueString()
+		relationProp.Title = &title
+	}
+	if !prop.Many.IsNull() {
+		many := prop.Many.ValueBool()
+		relationProp.Many = &many
+	}
 
-		relations[identifier] = relationProp
+	if !prop.Required.IsNull() {
+		required := prop.Required.ValueBool()
+		relationProp.Required = &required
 	}
 
-	return relations
+	if !prop.Description.IsNull() {
+		description := prop.Description.ValueString()
+		relationProp.Description = &description
+	}
+
+	return relationProp
 }
 
 func mirrorPropertiesToBody(state *BlueprintModel) map[string]cli.BlueprintMirrorProperty {
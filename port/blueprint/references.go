@@ -0,0 +1,207 @@
+package blueprint
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/samber/lo"
+)
+
+// blueprintReferencesView holds what paths can reference in a blueprint: its relations mapped to their targets and
+// the identifiers of its properties
+type blueprintReferencesView struct {
+	identifier string
+	relations  map[string]string
+	properties map[string]bool
+}
+
+func cliBlueprintReferencesView(b *cli.Blueprint) *blueprintReferencesView {
+	v := &blueprintReferencesView{identifier: b.Identifier, relations: make(map[string]string), properties: make(map[string]bool)}
+	for identifier, relation := range b.Relations {
+		if relation.Target != nil {
+			v.relations[identifier] = *relation.Target
+		}
+	}
+	for _, identifiers := range [][]string{lo.Keys(b.Schema.Properties), lo.Keys(b.MirrorProperties), lo.Keys(b.CalculationProperties), lo.Keys(b.AggregationProperties)} {
+		for _, identifier := range identifiers {
+			v.properties[identifier] = true
+		}
+	}
+	return v
+}
+
+// planBlueprintReferencesView builds the view of the planned blueprint, the existing blueprint fills in what is
+// managed outside of this resource
+func planBlueprintReferencesView(plan *BlueprintModel, existing *cli.Blueprint) *blueprintReferencesView {
+	v := &blueprintReferencesView{identifier: plan.Identifier.ValueString(), relations: make(map[string]string), properties: make(map[string]bool)}
+	if existing != nil {
+		existingView := cliBlueprintReferencesView(existing)
+		if plan.IgnoreExternalRelations.ValueBool() {
+			v.relations = existingView.relations
+		}
+		if plan.IgnoreExternalProperties.ValueBool() {
+			for identifier := range existing.Schema.Properties {
+				v.properties[identifier] = true
+			}
+		}
+		// aggregation properties are managed in a different resource
+		for identifier := range existing.AggregationProperties {
+			v.properties[identifier] = true
+		}
+	}
+	for identifier, relation := range plan.Relations {
+		// relations with a target that is only known after apply can't be followed
+		v.relations[identifier] = ""
+		if !relation.Target.IsUnknown() {
+			v.relations[identifier] = relation.Target.ValueString()
+		}
+	}
+	for _, identifiers := range [][]string{lo.Keys(propertiesTypes(plan.Properties)), lo.Keys(plan.MirrorProperties), lo.Keys(plan.CalculationProperties)} {
+		for _, identifier := range identifiers {
+			v.properties[identifier] = true
+		}
+	}
+	return v
+}
+
+type blueprintReferencesResolver struct {
+	portClient *cli.PortClient
+	self       *blueprintReferencesView
+	views      map[string]*blueprintReferencesView
+}
+
+// get returns the view of the blueprint, nil when it doesn't exist (yet)
+func (r *blueprintReferencesResolver) get(ctx context.Context, identifier string) (*blueprintReferencesView, error) {
+	if identifier == r.self.identifier {
+		return r.self, nil
+	}
+	if v, ok := r.views[identifier]; ok {
+		return v, nil
+	}
+	b, statusCode, err := r.portClient.ReadBlueprint(ctx, identifier)
+	if err != nil {
+		if statusCode == 404 {
+			r.views[identifier] = nil
+			return nil, nil
+		}
+		return nil, err
+	}
+	r.views[identifier] = cliBlueprintReferencesView(b)
+	return r.views[identifier], nil
+}
+
+// report fails the plan for the references that the planned blueprint can't resolve. The references that other
+// blueprints can't resolve only warn, as those blueprints can change in the same apply, e.g. through
+// port_blueprint_property, and are skipped when they are known to.
+func (r *blueprintReferencesResolver) report(v *blueprintReferencesView, attributePath path.Path, detail string, diags *diag.Diagnostics) {
+	switch {
+	case v == r.self:
+		diags.AddAttributeError(attributePath, "Invalid blueprint reference", detail)
+	case r.portClient.PendingBlueprints.Contains(v.identifier):
+	default:
+		diags.AddAttributeWarning(attributePath, "Blueprint reference not found", fmt.Sprintf("%s, the apply fails unless it is added to blueprint %s before this blueprint", detail, v.identifier))
+	}
+}
+
+// validatePath follows a path of relations from the planned blueprint, when lastIsProperty is set the last segment of
+// the path is a property of the blueprint the relations lead to
+func (r *blueprintReferencesResolver) validatePath(ctx context.Context, attributePath path.Path, referencePath string, lastIsProperty bool, diags *diag.Diagnostics) {
+	segments := strings.Split(referencePath, ".")
+	if lastIsProperty && len(segments) < 2 {
+		diags.AddAttributeError(attributePath, "Invalid blueprint reference", fmt.Sprintf("path %s must be in the format <relation>.<property>", referencePath))
+		return
+	}
+
+	v := r.self
+	for i, segment := range segments {
+		if i == len(segments)-1 && lastIsProperty {
+			// meta properties such as $title and $identifier exist in every blueprint
+			if !strings.HasPrefix(segment, "$") && !v.properties[segment] {
+				r.report(v, attributePath, fmt.Sprintf("path %s: property %s doesn't exist in blueprint %s", referencePath, segment, v.identifier), diags)
+			}
+			return
+		}
+
+		target, ok := v.relations[segment]
+		if !ok {
+			available := lo.Keys(v.relations)
+			sort.Strings(available)
+			r.report(v, attributePath, fmt.Sprintf("path %s: relation %s doesn't exist in blueprint %s, available relations are: %s", referencePath, segment, v.identifier, strings.Join(available, ", ")), diags)
+			return
+		}
+		if target == "" {
+			return
+		}
+		next, err := r.get(ctx, target)
+		if err != nil {
+			diags.AddError("failed to read blueprint", err.Error())
+			return
+		}
+		// the target blueprint doesn't exist yet, the relation to it is reported separately
+		if next == nil {
+			return
+		}
+		v = next
+	}
+}
+
+// validateBlueprintReferences checks that relation targets exist or are planned in this plan, and that the mirror
+// properties and team inheritance paths resolve to real relations and properties
+func validateBlueprintReferences(ctx context.Context, portClient *cli.PortClient, plan *BlueprintModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.Identifier.IsUnknown() {
+		return diags
+	}
+
+	existing, statusCode, err := portClient.ReadBlueprint(ctx, plan.Identifier.ValueString())
+	if err != nil && statusCode != 404 {
+		diags.AddError("failed to read blueprint", err.Error())
+		return diags
+	}
+
+	resolver := &blueprintReferencesResolver{
+		portClient: portClient,
+		self:       planBlueprintReferencesView(plan, existing),
+		views:      make(map[string]*blueprintReferencesView),
+	}
+
+	relations := lo.Keys(plan.Relations)
+	sort.Strings(relations)
+	for _, identifier := range relations {
+		target := plan.Relations[identifier].Target
+		if target.IsUnknown() || target.IsNull() {
+			continue
+		}
+		v, err := resolver.get(ctx, target.ValueString())
+		if err != nil {
+			diags.AddError("failed to read blueprint", err.Error())
+			return diags
+		}
+		// the targets planned in this plan, e.g. through port_blueprint.<name>.identifier, are created before this
+		// blueprint
+		if v == nil && !portClient.PendingBlueprints.Contains(target.ValueString()) {
+			diags.AddAttributeError(path.Root("relations").AtMapKey(identifier).AtName("target"), "Relation target not found", fmt.Sprintf("blueprint %s doesn't exist and isn't planned in this plan, reference it through port_blueprint.<name>.identifier if it is created in the same apply. Blueprints that relate to each other can manage one direction of the relation with port_blueprint_relation", target.ValueString()))
+		}
+	}
+
+	mirrorProperties := lo.Keys(plan.MirrorProperties)
+	sort.Strings(mirrorProperties)
+	for _, identifier := range mirrorProperties {
+		mirrorPath := plan.MirrorProperties[identifier].Path
+		if mirrorPath.IsUnknown() || mirrorPath.IsNull() {
+			continue
+		}
+		resolver.validatePath(ctx, path.Root("mirror_properties").AtMapKey(identifier).AtName("path"), mirrorPath.ValueString(), true, &diags)
+	}
+
+	if plan.TeamInheritance != nil && !plan.TeamInheritance.Path.IsUnknown() && !plan.TeamInheritance.Path.IsNull() {
+		resolver.validatePath(ctx, path.Root("team_inheritance").AtName("path"), plan.TeamInheritance.Path.ValueString(), false, &diags)
+	}
+
+	return diags
+}
@@ -0,0 +1,105 @@
+package blueprint
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/porttest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/samber/lo"
+)
+
+func newTestClient(t *testing.T) *cli.PortClient {
+	server := porttest.NewServer()
+	t.Cleanup(server.Close)
+	portClient, err := cli.New(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = portClient.Authenticate(context.Background(), server.ClientID, server.ClientSecret); err != nil {
+		t.Fatal(err)
+	}
+	return portClient
+}
+
+func microservicePlan(target string, mirrorPath string) *BlueprintModel {
+	return &BlueprintModel{
+		Identifier: types.StringValue("microservice"),
+		Relations: map[string]RelationModel{
+			"environment": {Target: types.StringValue(target)},
+		},
+		MirrorProperties: map[string]MirrorPropertyModel{
+			"region": {Path: types.StringValue(mirrorPath)},
+		},
+	}
+}
+
+func summaries(diags diag.Diagnostics) []string {
+	return lo.Map(diags, func(d diag.Diagnostic, _ int) string { return d.Severity().String() + ": " + d.Summary() })
+}
+
+func TestValidateBlueprintReferences(t *testing.T) {
+	ctx := context.Background()
+	portClient := newTestClient(t)
+	environment := &cli.Blueprint{
+		Identifier: "environment",
+		Title:      "Environment",
+		Schema:     cli.BlueprintSchema{Properties: map[string]cli.BlueprintProperty{"region": {Type: "string"}}},
+	}
+	if _, err := portClient.CreateBlueprint(ctx, environment, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		plan     *BlueprintModel
+		pending  []string
+		expected []string
+	}{
+		{
+			name: "valid path",
+			plan: microservicePlan("environment", "environment.region"),
+		},
+		{
+			name:     "missing relation of the planned blueprint",
+			plan:     microservicePlan("environment", "env.region"),
+			expected: []string{"Error: Invalid blueprint reference"},
+		},
+		{
+			name:     "missing property of another blueprint",
+			plan:     microservicePlan("environment", "environment.zone"),
+			expected: []string{"Warning: Blueprint reference not found"},
+		},
+		{
+			name:    "missing property of a blueprint that changes in the same apply",
+			plan:    microservicePlan("environment", "environment.zone"),
+			pending: []string{"environment"},
+		},
+		{
+			name:     "missing relation target",
+			plan:     microservicePlan("cluster", "environment.region"),
+			expected: []string{"Error: Relation target not found"},
+		},
+		{
+			name:    "missing relation target planned in the same plan",
+			plan:    microservicePlan("cluster", "environment.region"),
+			pending: []string{"cluster"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			portClient.PendingBlueprints = &utils.KeySet{}
+			for _, identifier := range tt.pending {
+				portClient.PendingBlueprints.Add(identifier)
+			}
+			got := summaries(validateBlueprintReferences(ctx, portClient, tt.plan))
+			if strings.Join(got, "\n") != strings.Join(tt.expected, "\n") {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
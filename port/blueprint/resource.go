@@ -3,6 +3,7 @@ package blueprint
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -10,12 +11,18 @@ import (
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/samber/lo"
 	"strings"
 	"time"
 )
 
 var _ resource.Resource = &BlueprintResource{}
 var _ resource.ResourceWithImportState = &BlueprintResource{}
+var _ resource.ResourceWithModifyPlan = &BlueprintResource{}
+var _ resource.ResourceWithUpgradeState = &BlueprintResource{}
+var _ resource.ResourceWithMoveState = &BlueprintResource{}
 
 func NewBlueprintResource() resource.Resource {
 	return &BlueprintResource{}
@@ -85,6 +92,18 @@ func refreshBlueprintState(ctx context.Context, bm *BlueprintModel, b *cli.Bluep
 		bm.ForceDeleteEntities = types.BoolValue(false)
 	}
 
+	if bm.AllowDataLoss.IsNull() {
+		bm.AllowDataLoss = types.BoolValue(false)
+	}
+
+	if bm.IgnoreExternalProperties.IsNull() {
+		bm.IgnoreExternalProperties = types.BoolValue(false)
+	}
+
+	if bm.IgnoreExternalRelations.IsNull() {
+		bm.IgnoreExternalRelations = types.BoolValue(false)
+	}
+
 	if b.ChangelogDestination != nil {
 		if b.ChangelogDestination.Type == consts.Kafka {
 			bm.KafkaChangelogDestination, _ = types.ObjectValue(nil, nil)
@@ -104,15 +123,22 @@ func refreshBlueprintState(ctx context.Context, bm *BlueprintModel, b *cli.Bluep
 	}
 
 	if len(b.Schema.Properties) > 0 {
-		err := updatePropertiesToState(ctx, b, bm)
-		if err != nil {
-			return err
+		properties := PropertiesToState(ctx, b.Schema)
+		if bm.IgnoreExternalProperties.ValueBool() {
+			// properties that weren't managed by this resource are managed elsewhere (e.g. port_blueprint_property)
+			properties = filterProperties(properties, propertiesIdentifiers(bm.Properties))
 		}
+		bm.Properties = properties
 	}
 
+	managedRelations := lo.Keys(bm.Relations)
 	if len(b.Relations) > 0 {
 		addRelationsToState(b, bm)
 	}
+	if bm.IgnoreExternalRelations.ValueBool() {
+		// relations that weren't managed by this resource are managed elsewhere (e.g. port_blueprint_relation)
+		bm.Relations = filterRelations(bm.Relations, managedRelations)
+	}
 
 	if len(b.MirrorProperties) > 0 {
 		addMirrorPropertiesToState(b, bm)
@@ -164,6 +190,18 @@ func writeBlueprintComputedFieldsToState(state *BlueprintModel, bp *cli.Blueprin
 	if state.ForceDeleteEntities.IsNull() {
 		state.ForceDeleteEntities = types.BoolValue(false)
 	}
+
+	if state.AllowDataLoss.IsNull() {
+		state.AllowDataLoss = types.BoolValue(false)
+	}
+
+	if state.IgnoreExternalProperties.IsNull() {
+		state.IgnoreExternalProperties = types.BoolValue(false)
+	}
+
+	if state.IgnoreExternalRelations.IsNull() {
+		state.IgnoreExternalRelations = types.BoolValue(false)
+	}
 }
 
 func (r *BlueprintResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -192,6 +230,9 @@ func (r *BlueprintResource) Update(ctx context.Context, req resource.UpdateReque
 			return
 		}
 	} else {
+		// the blueprint is read and written back, other resources can update its properties and relations in parallel
+		unlock := utils.BlueprintMutex.Lock(previousState.Identifier.ValueString())
+		defer unlock()
 		existingBp, statusCode, err := r.portClient.ReadBlueprint(ctx, previousState.Identifier.ValueString())
 		if err != nil {
 			if statusCode == 404 {
@@ -204,6 +245,14 @@ func (r *BlueprintResource) Update(ctx context.Context, req resource.UpdateReque
 		// aggregation properties are managed in a different resource, so we need to keep them in the update
 		// to avoid losing them
 		b.AggregationProperties = existingBp.AggregationProperties
+		if state.IgnoreExternalProperties.ValueBool() {
+			// keep the properties that were never managed by this resource, they are managed elsewhere
+			keepExternalProperties(b, existingBp, propertiesIdentifiers(previousState.Properties))
+		}
+		if state.IgnoreExternalRelations.ValueBool() {
+			// keep the relations that were never managed by this resource, they are managed elsewhere
+			keepExternalRelations(b, existingBp, managedRelations(previousState))
+		}
 		bp, err = r.portClient.UpdateBlueprint(ctx, b, previousState.ID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError("failed to update blueprint", err.Error())
@@ -234,53 +283,110 @@ func (r *BlueprintResource) Delete(ctx context.Context, req resource.DeleteReque
 	// therefor we want to be backwards compatible and assume that the user want to have deletion protection
 	forceDeleteEntities := state.ForceDeleteEntities.ValueBool()
 
-	if !forceDeleteEntities {
-		err := r.portClient.DeleteBlueprint(ctx, state.Identifier.ValueString())
-		if err != nil {
-			if strings.Contains(err.Error(), "has_dependents") {
-				resp.Diagnostics.AddError("failed to delete blueprint", fmt.Sprintf(`Blueprint %s has dependant entities that aren't managed by terraform, if you still wish to destroy the blueprint and delete all entities, set the force_delete_entities argument to true`, state.Identifier.ValueString()))
-				return
-			}
-			resp.Diagnostics.AddError("failed to delete blueprint", err.Error())
-			return
+	resp.Diagnostics.Append(DeleteBlueprint(ctx, r.portClient, state.Identifier.ValueString(), forceDeleteEntities)...)
+}
+
+func (r *BlueprintResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.portClient == nil {
+		return
+	}
+	r.portClient.PendingBlueprints.AddPlannedChange(ctx, req, path.Root("identifier"))
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *BlueprintModel
+	// Whole attributes that are only known after apply can't be validated
+	if req.Plan.Get(ctx, &plan).HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateBlueprintReferences(ctx, r.portClient, plan)...)
+
+	// Only updates of an existing blueprint can lose entities data
+	if req.State.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *BlueprintModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes := destructiveBlueprintChanges(state, plan)
+	if len(changes) == 0 {
+		return
+	}
+
+	entities, err := r.portClient.SearchBlueprintEntities(ctx, state.Identifier.ValueString(), []string{"identifier", "properties", "relations"})
+	if err != nil {
+		resp.Diagnostics.AddWarning("failed to count the entities of the blueprint", err.Error())
+		return
+	}
+	countEntitiesWithValues(changes, entities)
+
+	var lines []string
+	for _, change := range changes {
+		if change.Entities > 0 {
+			lines = append(lines, fmt.Sprintf("  - %s", change))
 		}
-	} else {
-		forceDeleteBlueprint(ctx, r.portClient, state, resp)
+	}
+	if len(lines) == 0 {
+		return
 	}
 
+	summary := fmt.Sprintf("Blueprint %s change deletes entities data", state.Identifier.ValueString())
+	detail := fmt.Sprintf("The following changes delete the values entities hold for them:\n%s", strings.Join(lines, "\n"))
+	if plan.AllowDataLoss.ValueBool() {
+		resp.Diagnostics.AddWarning(summary, detail)
+		return
+	}
+	resp.Diagnostics.AddError(summary, detail+"\n\nIf you still wish to apply this change, set the allow_data_loss argument to true")
 }
 
 func (r *BlueprintResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importid.Import(ctx, req, resp, "identifier")
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("identifier"), req.ID,
-	)...)
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("id"), req.ID,
-	)...)
+// DeleteBlueprint deletes the blueprint, with all its entities when forceDeleteEntities is set
+func DeleteBlueprint(ctx context.Context, portClient *cli.PortClient, identifier string, forceDeleteEntities bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !forceDeleteEntities {
+		err := portClient.DeleteBlueprint(ctx, identifier)
+		if err != nil {
+			if strings.Contains(err.Error(), "has_dependents") {
+				diags.AddError("failed to delete blueprint", fmt.Sprintf(`Blueprint %s has dependant entities that aren't managed by terraform, if you still wish to destroy the blueprint and delete all entities, set the force_delete_entities argument to true`, identifier))
+				return diags
+			}
+			diags.AddError("failed to delete blueprint", err.Error())
+		}
+		return diags
+	}
+	return forceDeleteBlueprint(ctx, portClient, identifier)
 }
 
-func forceDeleteBlueprint(ctx context.Context, portClient *cli.PortClient, state *BlueprintModel, resp *resource.DeleteResponse) {
-	migrationId, err := portClient.DeleteBlueprintWithAllEntities(ctx, state.Identifier.ValueString())
+func forceDeleteBlueprint(ctx context.Context, portClient *cli.PortClient, identifier string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	migrationId, err := portClient.DeleteBlueprintWithAllEntities(ctx, identifier)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to delete blueprint", err.Error())
-		return
+		diags.AddError("failed to delete blueprint", err.Error())
+		return diags
 	}
 	// query migration status until status is SUCCESS or FAILED
 	for {
 		migration, err := portClient.GetMigration(ctx, *migrationId)
 		if err != nil {
-			resp.Diagnostics.AddError("failed to get migration status", err.Error())
-			return
+			diags.AddError("failed to get migration status", err.Error())
+			return diags
 		}
 		if migration.Status == consts.Failure {
-			resp.Diagnostics.AddError("failed to delete blueprint", "migration failed")
-			return
+			diags.AddError("failed to delete blueprint", "migration failed")
+			return diags
 		}
 		if migration.Status == consts.Cancelled {
-			resp.Diagnostics.AddError("failed to delete blueprint", "migration was cancelled")
-			return
+			diags.AddError("failed to delete blueprint", "migration was cancelled")
+			return diags
 		}
 		if migration.Status == consts.Completed {
 			tflog.Info(ctx, "Migration completed successfully", map[string]interface{}{
@@ -289,11 +395,12 @@ func forceDeleteBlueprint(ctx context.Context, portClient *cli.PortClient, state
 			break
 		}
 		if err != nil {
-			resp.Diagnostics.AddError("failed to get migration status", err.Error())
-			return
+			diags.AddError("failed to get migration status", err.Error())
+			return diags
 		}
 		time.Sleep(5 * time.Second)
 	}
+	return diags
 }
 
 func blueprintResourceToPortRequest(ctx context.Context, state *BlueprintModel) (*cli.Blueprint, error) {
@@ -339,7 +446,7 @@ func blueprintResourceToPortRequest(ctx context.Context, state *BlueprintModel)
 	props := map[string]cli.BlueprintProperty{}
 	var err error
 	if state.Properties != nil {
-		props, required, err = propsResourceToBody(ctx, state)
+		props, required, err = PropertiesToBody(ctx, state.Properties)
 		if err != nil {
 			return nil, err
 		}
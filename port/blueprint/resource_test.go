@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"os"
 	"regexp"
 	"strconv"
 	"testing"
@@ -922,14 +921,14 @@ func TestAccPortBlueprintCatalogPageCreation(t *testing.T) {
 }
 
 func initializePortTestClient(t *testing.T) (*cli.PortClient, context.Context, error) {
-	baseUrl := os.Getenv("PORT_BASE_URL")
-	clientId := os.Getenv("PORT_CLIENT_ID")
-	clientSecret := os.Getenv("PORT_CLIENT_SECRET")
+	baseUrl := acctest.BaseUrl
+	clientId := acctest.ClientID
+	clientSecret := acctest.ClientSecret
 
 	if baseUrl == "" {
 		baseUrl = consts.DefaultBaseUrl
 	}
-	c, err := cli.New(baseUrl, cli.WithHeader("User-Agent", version.ProviderVersion))
+	c, err := cli.New(baseUrl, cli.WithHeader("User-Agent", version.ProviderVersion), cli.WithTransport(acctest.Transport))
 	if err != nil {
 		t.Fatalf("Failed to create Port-labs client: %s", err.Error())
 	}
@@ -941,3 +940,213 @@ func initializePortTestClient(t *testing.T) (*cli.PortClient, context.Context, e
 	}
 	return c, ctx, nil
 }
+
+func TestAccPortBlueprintDataLoss(t *testing.T) {
+	identifier := utils.GenID()
+	var testAccBaseBlueprintConfig = func(properties string, allowDataLoss bool) string {
+		return fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test"
+		icon = "Terraform"
+		identifier = "%s"
+		allow_data_loss = %t
+		properties = {
+			string_props = {
+				"language" = {
+					title = "Language"
+				}
+				%s
+			}
+		}
+	}
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity"
+		blueprint = port_blueprint.microservice.identifier
+		properties = {
+			string_props = {
+				"language" = "go"
+			}
+		}
+	}
+	`, identifier, allowDataLoss, properties)
+	}
+	var ownerProperty = `
+				"owner" = {
+					title = "Owner"
+				}`
+	var testAccEntityWithOwnerConfig = fmt.Sprintf(`
+	resource "port_entity" "owned" {
+		title = "TF Provider Test Owned Entity"
+		blueprint = "%s"
+		properties = {
+			string_props = {
+				"owner" = "platform"
+			}
+		}
+		depends_on = [port_blueprint.microservice]
+	}
+	`, identifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccBaseBlueprintConfig(ownerProperty, false) + testAccEntityWithOwnerConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint.microservice", "allow_data_loss", "false"),
+					resource.TestCheckResourceAttr("port_entity.owned", "properties.string_props.owner", "platform"),
+				),
+			},
+			{
+				// the owned entity still holds a value for the removed property
+				Config:      acctest.ProviderConfig + testAccBaseBlueprintConfig("", false) + testAccEntityWithOwnerConfig,
+				ExpectError: regexp.MustCompile(`property owner was removed \(1 entities hold a value for it\)`),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccBaseBlueprintConfig(ownerProperty, true) + testAccEntityWithOwnerConfig,
+				Check:  resource.TestCheckResourceAttr("port_blueprint.microservice", "allow_data_loss", "true"),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccBaseBlueprintConfig("", true),
+				Check:  resource.TestCheckNoResourceAttr("port_blueprint.microservice", "properties.string_props.owner"),
+			},
+		},
+	})
+}
+
+func TestAccPortBlueprintInvalidReferences(t *testing.T) {
+	identifier1 := utils.GenID()
+	identifier2 := utils.GenID()
+	var testAccEnvironmentConfigWithProperty = func(property string) string {
+		return fmt.Sprintf(`
+	resource "port_blueprint" "environment" {
+		title = "TF Provider Test Environment"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			string_props = {
+				"%s" = {
+					title = "Region"
+				}
+			}
+		}
+	}
+`, identifier1, property)
+	}
+	var testAccEnvironmentConfig = testAccEnvironmentConfigWithProperty("region")
+	var testAccMicroserviceConfigWithEnvironment = func(environmentConfig string, mirrorPath string, teamInheritancePath string) string {
+		return environmentConfig + fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test Microservice"
+		icon = "Terraform"
+		identifier = "%s"
+		mirror_properties = {
+			"region" = {
+				title = "Region"
+				path = "%s"
+			}
+		}
+		team_inheritance = {
+			path = "%s"
+		}
+		relations = {
+			"environment" = {
+				title = "Environment"
+				target = port_blueprint.environment.identifier
+			}
+		}
+	}
+`, identifier2, mirrorPath, teamInheritancePath)
+	}
+	var testAccMicroserviceConfig = func(mirrorPath string, teamInheritancePath string) string {
+		return testAccMicroserviceConfigWithEnvironment(testAccEnvironmentConfig, mirrorPath, teamInheritancePath)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccMicroserviceConfig("env.region", "environment"),
+				ExpectError: regexp.MustCompile(`(?s)path env.region: relation env doesn't exist in blueprint.*available relations are: environment`),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccEnvironmentConfig,
+			},
+			{
+				Config: acctest.ProviderConfig + testAccMicroserviceConfig("environment.region", "environment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint.microservice", "mirror_properties.region.path", "environment.region"),
+					resource.TestCheckResourceAttr("port_blueprint.microservice", "team_inheritance.path", "environment"),
+				),
+			},
+			{
+				// the mirrored property is added to the environment in the same apply
+				Config: acctest.ProviderConfig + testAccMicroserviceConfigWithEnvironment(testAccEnvironmentConfigWithProperty("zone"), "environment.zone", "environment"),
+				Check:  resource.TestCheckResourceAttr("port_blueprint.microservice", "mirror_properties.region.path", "environment.zone"),
+			},
+		},
+	})
+}
+
+func TestAccPortBlueprintsRelatedToEachOther(t *testing.T) {
+	identifier1 := utils.GenID()
+	identifier2 := utils.GenID()
+	var testAccMicroserviceConfig = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test Microservice"
+		icon = "Terraform"
+		identifier = "%s"
+		relations = {
+			"environment" = {
+				title = "Environment"
+				target = port_blueprint.environment.identifier
+			}
+		}
+	}
+`, identifier2)
+	var testAccEnvironmentConfig = func(ignoreExternalRelations bool, relations string) string {
+		return fmt.Sprintf(`
+	resource "port_blueprint" "environment" {
+		title = "TF Provider Test Environment"
+		icon = "Terraform"
+		identifier = "%s"
+		ignore_external_relations = %t
+		%s
+	}
+`, identifier1, ignoreExternalRelations, relations)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// the microservice is planned after the environment, as it references it
+				Config: acctest.ProviderConfig + testAccMicroserviceConfig + testAccEnvironmentConfig(false, fmt.Sprintf(`relations = {
+			"microservice" = {
+				title = "Microservice"
+				target = "%s"
+			}
+		}`, identifier2)),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`(?s)blueprint %s doesn't exist.*isn't.*planned`, identifier2)),
+			},
+			{
+				// one direction of the relation is managed by port_blueprint_relation
+				Config: acctest.ProviderConfig + testAccMicroserviceConfig + testAccEnvironmentConfig(true, "") + `
+	resource "port_blueprint_relation" "environment_microservice" {
+		blueprint_identifier = port_blueprint.environment.identifier
+		relation_identifier = "microservice"
+		target = port_blueprint.microservice.identifier
+		title = "Microservice"
+	}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint_relation.environment_microservice", "target", identifier2),
+					resource.TestCheckResourceAttr("port_blueprint.microservice", "relations.environment.target", identifier1),
+				),
+			},
+		},
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
@@ -40,7 +41,7 @@ func MetadataProperties() map[string]schema.Attribute {
 
 }
 
-func StringPropertySchema() schema.Attribute {
+func StringPropertyAttributes() map[string]schema.Attribute {
 	stringPropertySchema := map[string]schema.Attribute{
 		"default": schema.StringAttribute{
 			MarkdownDescription: "The default of the string property",
@@ -108,16 +109,20 @@ func StringPropertySchema() schema.Attribute {
 	}
 
 	utils.CopyMaps(stringPropertySchema, MetadataProperties())
+	return stringPropertySchema
+}
+
+func StringPropertySchema() schema.Attribute {
 	return schema.MapNestedAttribute{
 		MarkdownDescription: "The string property of the blueprint",
 		Optional:            true,
 		NestedObject: schema.NestedAttributeObject{
-			Attributes: stringPropertySchema,
+			Attributes: StringPropertyAttributes(),
 		},
 	}
 }
 
-func NumberPropertySchema() schema.Attribute {
+func NumberPropertyAttributes() map[string]schema.Attribute {
 	numberPropertySchema := map[string]schema.Attribute{
 		"default": schema.Float64Attribute{
 			MarkdownDescription: "The default of the number property",
@@ -148,16 +153,20 @@ func NumberPropertySchema() schema.Attribute {
 	}
 
 	utils.CopyMaps(numberPropertySchema, MetadataProperties())
+	return numberPropertySchema
+}
+
+func NumberPropertySchema() schema.Attribute {
 	return schema.MapNestedAttribute{
 		MarkdownDescription: "The number property of the blueprint",
 		Optional:            true,
 		NestedObject: schema.NestedAttributeObject{
-			Attributes: numberPropertySchema,
+			Attributes: NumberPropertyAttributes(),
 		},
 	}
 }
 
-func BooleanPropertySchema() schema.Attribute {
+func BooleanPropertyAttributes() map[string]schema.Attribute {
 	booleanPropertySchema := map[string]schema.Attribute{
 		"default": schema.BoolAttribute{
 			MarkdownDescription: "The default of the boolean property",
@@ -166,17 +175,20 @@ func BooleanPropertySchema() schema.Attribute {
 	}
 
 	utils.CopyMaps(booleanPropertySchema, MetadataProperties())
+	return booleanPropertySchema
+}
 
+func BooleanPropertySchema() schema.Attribute {
 	return schema.MapNestedAttribute{
 		MarkdownDescription: "The boolean property of the blueprint",
 		Optional:            true,
 		NestedObject: schema.NestedAttributeObject{
-			Attributes: booleanPropertySchema,
+			Attributes: BooleanPropertyAttributes(),
 		},
 	}
 }
 
-func ArrayPropertySchema() schema.MapNestedAttribute {
+func ArrayPropertyAttributes() map[string]schema.Attribute {
 	arrayPropertySchema := map[string]schema.Attribute{
 		"min_items": schema.Int64Attribute{
 			MarkdownDescription: "The min items of the array property",
@@ -243,17 +255,20 @@ func ArrayPropertySchema() schema.MapNestedAttribute {
 	}
 
 	utils.CopyMaps(arrayPropertySchema, MetadataProperties())
+	return arrayPropertySchema
+}
 
+func ArrayPropertySchema() schema.MapNestedAttribute {
 	return schema.MapNestedAttribute{
 		MarkdownDescription: "The array property of the blueprint",
 		Optional:            true,
 		NestedObject: schema.NestedAttributeObject{
-			Attributes: arrayPropertySchema,
+			Attributes: ArrayPropertyAttributes(),
 		},
 	}
 }
 
-func ObjectPropertySchema() schema.MapNestedAttribute {
+func ObjectPropertyAttributes() map[string]schema.Attribute {
 
 	objectPropertySchema := map[string]schema.Attribute{
 		"spec": schema.StringAttribute{
@@ -266,16 +281,20 @@ func ObjectPropertySchema() schema.MapNestedAttribute {
 		"default": schema.StringAttribute{
 			Optional:            true,
 			MarkdownDescription: "The default of the object property",
+			CustomType:          jsontypes.NormalizedType{},
 		},
 	}
 
 	utils.CopyMaps(objectPropertySchema, MetadataProperties())
+	return objectPropertySchema
+}
 
+func ObjectPropertySchema() schema.MapNestedAttribute {
 	return schema.MapNestedAttribute{
 		MarkdownDescription: "The object property of the blueprint",
 		Optional:            true,
 		NestedObject: schema.NestedAttributeObject{
-			Attributes: objectPropertySchema,
+			Attributes: ObjectPropertyAttributes(),
 		},
 	}
 }
@@ -458,6 +477,24 @@ func BlueprintSchema() map[string]schema.Attribute {
 			Computed:            true,
 			Default:             booldefault.StaticBool(false),
 		},
+		"allow_data_loss": schema.BoolAttribute{
+			MarkdownDescription: "If set to true, removing or changing the type of properties, relations and mirror properties that entities hold values for is reported as a warning instead of failing the plan",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"ignore_external_properties": schema.BoolAttribute{
+			MarkdownDescription: "If set to true, properties of the blueprint that aren't defined in this resource are ignored instead of being removed, use it when some of the properties are managed by the `port_blueprint_property` resource. The properties removed from this resource are still deleted, to move a property to `port_blueprint_property` without deleting its values import the blueprint again without it",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"ignore_external_relations": schema.BoolAttribute{
+			MarkdownDescription: "If set to true, relations of the blueprint that aren't defined in this resource are ignored instead of being removed, use it when some of the relations are managed by the `port_blueprint_relation` resource. The relations removed from this resource are still deleted, to move a relation to `port_blueprint_relation` without deleting its values import the blueprint again without it",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
 		"create_catalog_page": schema.BoolAttribute{
 			MarkdownDescription: "This flag is only relevant for blueprint creation, by default if not set, a catalog page will be created for the blueprint",
 			Optional:            true,
@@ -470,6 +507,7 @@ func BlueprintSchema() map[string]schema.Attribute {
 func (r *BlueprintResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: blueprintMarkdownDescription,
+		Version:             schemaVersion,
 		Attributes:          BlueprintSchema(),
 	}
 }
@@ -551,6 +589,39 @@ resource "port_blueprint" "microservice" {
 
 ` + "```" + `
 
+## Blueprints that Relate to Each Other
+
+The target of a relation must exist or be created in the same apply, reference it through ` + "`port_blueprint.<name>.identifier`" + ` so that it is created first. Blueprints that relate to each other can't reference each other that way without a dependency cycle, manage one direction of the relation with the ` + "`port_blueprint_relation`" + ` resource:
+
+` + "```hcl" + `
+
+resource "port_blueprint" "environment" {
+  title                     = "Environment"
+  icon                      = "Environment"
+  identifier                = "environment"
+  ignore_external_relations = true
+}
+
+resource "port_blueprint" "microservice" {
+  title      = "Microservice"
+  icon       = "Microservice"
+  identifier = "microservice"
+  relations = {
+    "environment" = {
+      target = port_blueprint.environment.identifier
+    }
+  }
+}
+
+resource "port_blueprint_relation" "environment_microservices" {
+  blueprint_identifier = port_blueprint.environment.identifier
+  relation_identifier  = "microservices"
+  target               = port_blueprint.microservice.identifier
+  many                 = true
+}
+
+` + "```" + `
+
 
 ## Example Usage with Mirror Properties
 
@@ -616,4 +687,22 @@ resource "port_blueprint" "microservice" {
 
 ` + "```" + `
 
+## Removing or Changing Properties
+
+Removing a property, a relation or a mirror property from the blueprint, or changing its type, deletes the values entities hold for it.
+When entities hold values for such properties, the plan fails and lists the affected properties and how many entities hold values for them.
+
+To apply the change anyway, you can set the argument ` + "`allow_data_loss=true`" + `, the affected properties will then be reported as warnings.
+
+## Moving from the 0.x Provider
+
+Blueprints managed with the ` + "`port-labs_blueprint`" + ` resource of the 0.x provider versions can be moved to ` + "`port_blueprint`" + ` without recreating them, requires Terraform 1.8 or later:
+
+` + "```hcl" + `
+moved {
+  from = port-labs_blueprint.microservice
+  to   = port_blueprint.microservice
+}
+` + "```" + `
+
 `
@@ -48,8 +48,8 @@ func addStringPropertiesToState(ctx context.Context, v *cli.BlueprintProperty) *
 	return stringProp
 }
 
-func stringPropResourceToBody(ctx context.Context, state *BlueprintModel, props map[string]cli.BlueprintProperty, required *[]string) error {
-	for propIdentifier, prop := range state.Properties.StringProps {
+func stringPropResourceToBody(ctx context.Context, properties *PropertiesModel, props map[string]cli.BlueprintProperty, required *[]string) error {
+	for propIdentifier, prop := range properties.StringProps {
 		property := cli.BlueprintProperty{
 			Type: "string",
 		}
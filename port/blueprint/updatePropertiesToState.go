@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 	"github.com/samber/lo"
 )
 
@@ -65,17 +66,18 @@ func setCommonProperties(v cli.BlueprintProperty, prop interface{}) {
 				case *ObjectPropModel:
 					js, _ := json.Marshal(v.Default)
 					value := string(js)
-					p.Default = types.StringValue(value)
+					p.Default = jsontypes.NewNormalizedValue(value)
 				}
 			}
 		}
 	}
 }
 
-func updatePropertiesToState(ctx context.Context, b *cli.Blueprint, bm *BlueprintModel) error {
+// PropertiesToState converts Port's blueprint schema into the typed properties model
+func PropertiesToState(ctx context.Context, blueprintSchema cli.BlueprintSchema) *PropertiesModel {
 	properties := &PropertiesModel{}
 
-	for k, v := range b.Schema.Properties {
+	for k, v := range blueprintSchema.Properties {
 		switch v.Type {
 		case "string":
 			if properties.StringProps == nil {
@@ -83,7 +85,7 @@ func updatePropertiesToState(ctx context.Context, b *cli.Blueprint, bm *Blueprin
 			}
 			stringProp := addStringPropertiesToState(ctx, &v)
 
-			if lo.Contains(b.Schema.Required, k) {
+			if lo.Contains(blueprintSchema.Required, k) {
 				stringProp.Required = types.BoolValue(true)
 			} else {
 				stringProp.Required = types.BoolValue(false)
@@ -100,7 +102,7 @@ func updatePropertiesToState(ctx context.Context, b *cli.Blueprint, bm *Blueprin
 
 			numberProp := addNumberPropertiesToState(ctx, &v)
 
-			if lo.Contains(b.Schema.Required, k) {
+			if lo.Contains(blueprintSchema.Required, k) {
 				numberProp.Required = types.BoolValue(true)
 			} else {
 				numberProp.Required = types.BoolValue(false)
@@ -117,7 +119,7 @@ func updatePropertiesToState(ctx context.Context, b *cli.Blueprint, bm *Blueprin
 
 			arrayProp := addArrayPropertiesToState(&v)
 
-			if lo.Contains(b.Schema.Required, k) {
+			if lo.Contains(blueprintSchema.Required, k) {
 				arrayProp.Required = types.BoolValue(true)
 			} else {
 				arrayProp.Required = types.BoolValue(false)
@@ -136,7 +138,7 @@ func updatePropertiesToState(ctx context.Context, b *cli.Blueprint, bm *Blueprin
 
 			setCommonProperties(v, booleanProp)
 
-			if lo.Contains(b.Schema.Required, k) {
+			if lo.Contains(blueprintSchema.Required, k) {
 				booleanProp.Required = types.BoolValue(true)
 			} else {
 				booleanProp.Required = types.BoolValue(false)
@@ -151,7 +153,7 @@ func updatePropertiesToState(ctx context.Context, b *cli.Blueprint, bm *Blueprin
 
 			objectProp := addObjectPropertiesToState(&v)
 
-			if lo.Contains(b.Schema.Required, k) {
+			if lo.Contains(blueprintSchema.Required, k) {
 				objectProp.Required = types.BoolValue(true)
 			} else {
 				objectProp.Required = types.BoolValue(false)
@@ -165,9 +167,7 @@ func updatePropertiesToState(ctx context.Context, b *cli.Blueprint, bm *Blueprin
 
 	}
 
-	bm.Properties = properties
-
-	return nil
+	return properties
 }
 
 func addRelationsToState(b *cli.Blueprint, bm *BlueprintModel) {
@@ -176,16 +176,19 @@ func addRelationsToState(b *cli.Blueprint, bm *BlueprintModel) {
 			bm.Relations = make(map[string]RelationModel)
 		}
 
-		relationModel := &RelationModel{
-			Target:   		types.StringValue(*v.Target),
-			Title:    		flex.GoStringToFramework(v.Title),
-			Description:    	flex.GoStringToFramework(v.Description),
-			Many:     		flex.GoBoolToFramework(v.Many),
-			Required: 		flex.GoBoolToFramework(v.Required),
-		}
+		bm.Relations[k] = RelationToState(v)
 
-		bm.Relations[k] = *relationModel
+	}
+}
 
+// RelationToState converts Port's relation into the relation model
+func RelationToState(v cli.Relation) RelationModel {
+	return RelationModel{
+		Target:      types.StringValue(*v.Target),
+		Title:       flex.GoStringToFramework(v.Title),
+		Description: flex.GoStringToFramework(v.Description),
+		Many:        flex.GoBoolToFramework(v.Many),
+		Required:    flex.GoBoolToFramework(v.Required),
 	}
 }
 
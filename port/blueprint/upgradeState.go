@@ -0,0 +1,138 @@
+package blueprint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/stateupgrade"
+)
+
+// schemaVersion is bumped, with an upgrader of the prior version, whenever a change of the schema breaks the states
+// stored by the prior versions of the provider
+const schemaVersion = 1
+
+func (r *BlueprintResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: stateupgrade.Upgrader(upgradeStateV0),
+	}
+}
+
+// upgradeStateV0 upgrades the states stored before the schema was versioned. Those are either in the current layout,
+// or in the layout of the 0.x providers, where the properties, relations, mirror and calculation properties were
+// lists of blocks with an identifier, the property defaults were strings and the changelog destination had a type:
+//
+//	properties            = [{ identifier = "replicas", type = "number", default = "1" }, { identifier = "tags", type = "array", items = { type = "string" }, default_items = ["a"] }]
+//	relations             = [{ identifier = "system", target = "system" }]
+//	mirror_properties     = [{ identifier = "owner", path = "system.owner" }]
+//	changelog_destination = { type = "WEBHOOK", url = "https://example.com" }
+func upgradeStateV0(state stateupgrade.State) error {
+	if _, isList := state["properties"].([]any); isList {
+		properties, err := upgradePropertiesV0(stateupgrade.List(state, "properties"))
+		if err != nil {
+			return err
+		}
+		state["properties"] = properties
+	}
+	for _, attribute := range []string{"relations", "mirror_properties", "calculation_properties"} {
+		if blocks, isList := state[attribute].([]any); isList {
+			state[attribute] = byIdentifier(blocks)
+		}
+	}
+	for _, relation := range stateupgrade.Object(state, "relations") {
+		if r, isObject := relation.(map[string]any); isObject {
+			stateupgrade.SetDefault(r, "many", false)
+			stateupgrade.SetDefault(r, "required", false)
+		}
+	}
+
+	if changelogDestination := stateupgrade.Object(state, "changelog_destination"); changelogDestination != nil {
+		switch destinationType := stateupgrade.String(changelogDestination, "type"); destinationType {
+		case "WEBHOOK":
+			state["webhook_changelog_destination"] = map[string]any{
+				"url":   changelogDestination["url"],
+				"agent": changelogDestination["agent"],
+			}
+		case "KAFKA":
+			state["kafka_changelog_destination"] = map[string]any{}
+		default:
+			return fmt.Errorf("the changelog destination has the unknown type %s", destinationType)
+		}
+	}
+	delete(state, "changelog_destination")
+
+	stateupgrade.SetDefault(state, "force_delete_entities", false)
+	stateupgrade.SetDefault(state, "allow_data_loss", false)
+	stateupgrade.SetDefault(state, "ignore_external_properties", false)
+	stateupgrade.SetDefault(state, "ignore_external_relations", false)
+	stateupgrade.SetDefault(state, "create_catalog_page", true)
+	return nil
+}
+
+// upgradePropertiesV0 sorts the property blocks into the maps of their type, and converts their string defaults to
+// the type of the property
+func upgradePropertiesV0(blocks []any) (map[string]any, error) {
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+	properties := map[string]any{}
+	for _, b := range blocks {
+		block, _ := b.(map[string]any)
+		identifier := stateupgrade.String(block, "identifier")
+		propertyType := stateupgrade.String(block, "type")
+		property := map[string]any{}
+		for key, value := range block {
+			property[key] = value
+		}
+
+		defaultValue, hasDefault := block["default"].(string)
+		switch propertyType {
+		case "string", "object":
+		case "number", "boolean":
+			if hasDefault {
+				property["default"] = stateupgrade.TypedValue(defaultValue, propertyType)
+			}
+		case "array":
+			items := stateupgrade.Object(block, "items")
+			itemType := stateupgrade.String(items, "type")
+			if itemType == "" {
+				itemType = "string"
+			}
+			arrayItems := map[string]any{}
+			if itemType == "string" {
+				arrayItems["format"] = items["format"]
+			}
+			if defaultItems := stateupgrade.List(block, "default_items"); defaultItems != nil {
+				values := make([]any, 0, len(defaultItems))
+				for _, item := range defaultItems {
+					s, _ := item.(string)
+					values = append(values, stateupgrade.TypedValue(s, itemType))
+				}
+				arrayItems["default"] = values
+			}
+			property[itemType+"_items"] = arrayItems
+		default:
+			return nil, fmt.Errorf("the property %s has the unknown type %s", identifier, propertyType)
+		}
+
+		key := propertyType + "_props"
+		if properties[key] == nil {
+			properties[key] = map[string]any{}
+		}
+		properties[key].(map[string]any)[identifier] = property
+	}
+	return properties, nil
+}
+
+// byIdentifier converts a list of blocks to a map keyed by their identifiers
+func byIdentifier(blocks []any) map[string]any {
+	if len(blocks) == 0 {
+		return nil
+	}
+	m := map[string]any{}
+	for _, b := range blocks {
+		block, _ := b.(map[string]any)
+		m[stateupgrade.String(block, "identifier")] = block
+	}
+	return m
+}
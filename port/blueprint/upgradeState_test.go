@@ -0,0 +1,16 @@
+package blueprint_test
+
+import (
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint"
+)
+
+func TestUpgradeStateV0Blocks(t *testing.T) {
+	acctest.CheckStateUpgrade(t, &blueprint.BlueprintResource{}, 0, "testdata/state_v0_blocks.json", "testdata/state_v1_blocks.json")
+}
+
+func TestUpgradeStateV0Current(t *testing.T) {
+	acctest.CheckStateUpgrade(t, &blueprint.BlueprintResource{}, 0, "testdata/state_v0_current.json", "testdata/state_v0_current.json")
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 )
 
 type ArrayPropsModel struct {
@@ -12,11 +13,11 @@ type ArrayPropsModel struct {
 }
 
 type EntityPropertiesModel struct {
-	StringProps  map[string]types.String  `tfsdk:"string_props"`
-	NumberProps  map[string]types.Float64 `tfsdk:"number_props"`
-	BooleanProps map[string]types.Bool    `tfsdk:"boolean_props"`
-	ObjectProps  map[string]types.String  `tfsdk:"object_props"`
-	ArrayProps   *ArrayPropsModel         `tfsdk:"array_props"`
+	StringProps  map[string]types.String         `tfsdk:"string_props"`
+	NumberProps  map[string]types.Float64        `tfsdk:"number_props"`
+	BooleanProps map[string]types.Bool           `tfsdk:"boolean_props"`
+	ObjectProps  map[string]jsontypes.Normalized `tfsdk:"object_props"`
+	ArrayProps   *ArrayPropsModel                `tfsdk:"array_props"`
 }
 
 type RelationModel struct {
@@ -0,0 +1,12 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/entity"
+)
+
+func TestMoveStateFromLegacyEntity(t *testing.T) {
+	acctest.CheckStateMove(t, &entity.EntityResource{}, "port-labs_entity", 0, "testdata/state_v0_blocks.json", "testdata/state_v1_blocks.json")
+}
@@ -3,17 +3,24 @@ package entity
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/samber/lo"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &EntityResource{}
 var _ resource.ResourceWithImportState = &EntityResource{}
+var _ resource.ResourceWithModifyPlan = &EntityResource{}
+var _ resource.ResourceWithUpgradeState = &EntityResource{}
+var _ resource.ResourceWithMoveState = &EntityResource{}
 
 func NewEntityResource() resource.Resource {
 	return &EntityResource{}
@@ -186,14 +193,72 @@ func (r *EntityResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 }
 
-func (r *EntityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ":")
+func (r *EntityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.portClient == nil {
+		return
+	}
+	// Nothing to validate when the entity is destroyed or unchanged, an unchanged entity doesn't fail the plan after
+	// its blueprint or its related entities are changed outside of Terraform
+	if !utils.HasPlannedChanges(req) || req.Plan.Raw.IsNull() {
+		return
+	}
+	// the entities created in the same apply are valid targets of the relations of other entities
+	var blueprint, identifier types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("blueprint"), &blueprint)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("identifier"), &identifier)...)
+	if !blueprint.IsUnknown() && !identifier.IsUnknown() && !identifier.IsNull() {
+		r.portClient.PendingEntities.Add(blueprint.ValueString() + importid.Separator + identifier.ValueString())
+	}
+
+	var blueprintIdentifier types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("blueprint"), &blueprintIdentifier)...)
+	if resp.Diagnostics.HasError() || blueprintIdentifier.IsNull() || blueprintIdentifier.IsUnknown() {
+		return
+	}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError("invalid import ID", "import ID must be in the format <blueprint_id>:<entity_id>")
+	var propertiesObject types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("properties"), &propertiesObject)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Properties that depend on values known only after apply are validated by the API instead
+	if propertiesObject.IsUnknown() || lo.SomeBy(lo.Values(propertiesObject.Attributes()), attr.Value.IsUnknown) {
 		return
 	}
+	var properties *EntityPropertiesModel
+	if !propertiesObject.IsNull() {
+		resp.Diagnostics.Append(propertiesObject.As(ctx, &properties, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("blueprint"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), idParts[1])...)
+	var relations types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("relations"), &relations)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bp, statusCode, err := r.portClient.ReadPlannedBlueprint(ctx, blueprintIdentifier.ValueString())
+	if err != nil {
+		// The blueprint is created in the same apply
+		if statusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError("failed to read blueprint", err.Error())
+		return
+	}
+
+	diags := validateEntityProperties(properties, bp)
+	diags.Append(validateEntityRelations(relations, bp)...)
+	diags.Append(validateRelatedEntities(ctx, r.portClient, relations, bp)...)
+	if r.portClient.PendingBlueprints.Contains(bp.Identifier) {
+		// the blueprint in Port is outdated, it is changed by the same apply
+		diags = errorsAsWarnings(diags, fmt.Sprintf("blueprint %s is changed by the same apply, the entity is validated against the blueprint as it is in Port before the apply", bp.Identifier))
+	}
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *EntityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importid.Import(ctx, req, resp, "blueprint", "identifier")
 }
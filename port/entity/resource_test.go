@@ -2,6 +2,7 @@ package entity_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -826,3 +827,204 @@ func TestAccPortEntityWithDefaultArrayProp(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPortEntityInvalidAgainstBlueprint(t *testing.T) {
+	identifier := utils.GenID()
+	identifier2 := utils.GenID()
+	var testAccBlueprintsConfig = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  {
+					"title" = "My String Identifier"
+					"enum" = ["gold", "silver"]
+				}
+			}
+			"number_props" = {
+				"myNumberIdentifier" =  {
+					"title" = "My Number Identifier"
+					"maximum" = 10
+				}
+			}
+		}
+		relations = {
+			"tfRelation" = {
+				"title" = "Test Relation"
+				"target" = port_blueprint.microservice2.identifier
+			}
+		}
+	}
+	resource "port_blueprint" "microservice2" {
+		title = "TF Provider Test BP1"
+		icon = "Terraform"
+		identifier = "%s"
+	}
+	`, identifier, identifier2)
+
+	var testAccInvalidPropertiesConfig = testAccBlueprintsConfig + `
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  "bronze"
+			}
+			"number_props" = {
+				"myNumberIdentifier" =  11
+			}
+		}
+	}
+	`
+
+	var testAccUnknownPropertyConfig = testAccBlueprintsConfig + `
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		properties = {
+			"number_props" = {
+				"myStringIdentifier" =  1
+				"myMissingIdentifier" =  2
+			}
+		}
+	}
+	`
+
+	var testAccInvalidRelationConfig = testAccBlueprintsConfig + `
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		relations = {
+			many_relations = {
+				"tfRelation" = ["tf-entity-2"]
+			}
+		}
+	}
+	`
+
+	var testAccMissingRelatedEntityConfig = testAccBlueprintsConfig + `
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		relations = {
+			single_relations = {
+				"tfRelation" = "tf-missing-entity"
+			}
+		}
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccBlueprintsConfig,
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccMissingRelatedEntityConfig,
+				ExpectError: regexp.MustCompile(`entity tf-missing-entity doesn't exist in blueprint`),
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccInvalidPropertiesConfig,
+				ExpectError: regexp.MustCompile(`value "bronze" is not one of the allowed values: gold, silver`),
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccInvalidPropertiesConfig,
+				ExpectError: regexp.MustCompile(`value 11 is greater than the maximum of 10`),
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccUnknownPropertyConfig,
+				ExpectError: regexp.MustCompile(`property myStringIdentifier is of type string in blueprint`),
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccUnknownPropertyConfig,
+				ExpectError: regexp.MustCompile(`property myMissingIdentifier doesn't exist in blueprint`),
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccInvalidRelationConfig,
+				ExpectError: regexp.MustCompile(`(?s)relation tfRelation to .* is a single relation.*set in single_relations`),
+			},
+		},
+	})
+}
+
+func TestAccPortEntityWithBlueprintChangedInSameApply(t *testing.T) {
+	// The entity sets a property and a relation to an entity that are both added by the same apply, the plan
+	// validation against the blueprint in Port must not fail on them
+	identifier := utils.GenID()
+	identifier2 := utils.GenID()
+	config := func(withProperty bool) string {
+		property := ""
+		entity := ""
+		if withProperty {
+			property = `
+			"string_props" = {
+				"tier" = {
+					"title" = "Tier"
+					"enum" = ["gold", "silver"]
+				}
+			}`
+			entity = `
+	resource "port_entity" "target" {
+		title = "TF Provider Test Target"
+		blueprint = port_blueprint.microservice2.identifier
+	}
+
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		properties = {
+			"string_props" = {
+				"tier" = "gold"
+			}
+		}
+		relations = {
+			single_relations = {
+				"tfRelation" = port_entity.target.identifier
+			}
+		}
+	}`
+		}
+		return fmt.Sprintf(`
+	resource "port_blueprint" "microservice2" {
+		title = "TF Provider Test BP1"
+		icon = "Terraform"
+		identifier = "%s"
+	}
+
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {%s
+		}
+		relations = {
+			"tfRelation" = {
+				"title" = "Test Relation"
+				"target" = port_blueprint.microservice2.identifier
+			}
+		}
+	}
+	%s`, identifier2, identifier, property, entity)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + config(false),
+			},
+			{
+				Config: acctest.ProviderConfig + config(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_entity.microservice", "properties.string_props.tier", "gold"),
+				),
+			},
+		},
+	})
+}
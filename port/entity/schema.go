@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 )
 
 func EntitySchema() map[string]schema.Attribute {
@@ -66,7 +67,7 @@ func EntitySchema() map[string]schema.Attribute {
 				"object_props": schema.MapAttribute{
 					MarkdownDescription: "The object properties of the entity",
 					Optional:            true,
-					ElementType:         types.StringType,
+					ElementType:         jsontypes.NormalizedType{},
 				},
 				"array_props": schema.SingleNestedAttribute{
 					MarkdownDescription: "The array properties of the entity",
@@ -85,7 +86,7 @@ func EntitySchema() map[string]schema.Attribute {
 							Optional:    true,
 						},
 						"object_items": schema.MapAttribute{
-							ElementType: types.ListType{ElemType: types.StringType},
+							ElementType: types.ListType{ElemType: jsontypes.NormalizedType{}},
 							Optional:    true,
 						},
 					},
@@ -133,6 +134,7 @@ func EntitySchema() map[string]schema.Attribute {
 func (r *EntityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Entity resource",
+		Version:             schemaVersion,
 		Attributes:          EntitySchema(),
 	}
 }
@@ -0,0 +1,134 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/stateupgrade"
+)
+
+// schemaVersion is bumped, with an upgrader of the prior version, whenever a change of the schema breaks the states
+// stored by the prior versions of the provider
+const schemaVersion = 1
+
+func (r *EntityResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: stateupgrade.Upgrader(upgradeStateV0),
+	}
+}
+
+// upgradeStateV0 upgrades the states stored before the schema was versioned. Those are either in the current layout,
+// or in the layout of the 0.x providers, where the properties and relations were lists of blocks:
+//
+//	properties = [{ name = "language", type = "string", value = "Go" }, { name = "tags", items = ["a", "b"] }]
+//	relations  = [{ name = "system", identifier = "payments" }, { name = "envs", identifiers = ["prod"] }]
+//	team       = "backend"
+func upgradeStateV0(state stateupgrade.State) error {
+	if _, isList := state["properties"].([]any); isList {
+		properties, err := upgradePropertiesV0(stateupgrade.List(state, "properties"))
+		if err != nil {
+			return err
+		}
+		state["properties"] = properties
+	}
+	if _, isList := state["relations"].([]any); isList {
+		state["relations"] = upgradeRelationsV0(stateupgrade.List(state, "relations"))
+	}
+	if team := stateupgrade.String(state, "team"); team != "" && len(stateupgrade.List(state, "teams")) == 0 {
+		state["teams"] = []any{team}
+	}
+	delete(state, "team")
+	return nil
+}
+
+// upgradePropertiesV0 sorts the property blocks by their type, blocks stored without a type are sorted by the type
+// their value looks like, the next refresh corrects the properties whose type was inferred wrong
+func upgradePropertiesV0(blocks []any) (map[string]any, error) {
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+	props := map[string]map[string]any{}
+	items := map[string]map[string]any{}
+	add := func(m map[string]map[string]any, key string, name string, value any) {
+		if m[key] == nil {
+			m[key] = map[string]any{}
+		}
+		m[key][name] = value
+	}
+
+	for _, b := range blocks {
+		block, _ := b.(map[string]any)
+		name := stateupgrade.String(block, "name")
+		if name == "" {
+			return nil, fmt.Errorf("the property %v has no name", b)
+		}
+		propertyType := stateupgrade.String(block, "type")
+
+		if blockItems := stateupgrade.List(block, "items"); blockItems != nil || propertyType == "array" {
+			itemType := "string"
+			if len(blockItems) > 0 {
+				if s, isString := blockItems[0].(string); isString {
+					itemType = stateupgrade.InferType(s)
+				}
+			}
+			values := make([]any, 0, len(blockItems))
+			for _, item := range blockItems {
+				s, _ := item.(string)
+				values = append(values, stateupgrade.TypedValue(s, itemType))
+			}
+			add(items, itemType+"_items", name, values)
+			continue
+		}
+
+		value := stateupgrade.String(block, "value")
+		if propertyType == "" {
+			propertyType = stateupgrade.InferType(value)
+		}
+		switch propertyType {
+		case "string", "number", "boolean", "object":
+			add(props, propertyType+"_props", name, stateupgrade.TypedValue(value, propertyType))
+		default:
+			return nil, fmt.Errorf("the property %s has the unknown type %s", name, propertyType)
+		}
+	}
+
+	properties := map[string]any{}
+	for key, values := range props {
+		properties[key] = values
+	}
+	if len(items) > 0 {
+		arrayProps := map[string]any{}
+		for key, values := range items {
+			arrayProps[key] = values
+		}
+		properties["array_props"] = arrayProps
+	}
+	return properties, nil
+}
+
+func upgradeRelationsV0(blocks []any) map[string]any {
+	if len(blocks) == 0 {
+		return nil
+	}
+	single := map[string]any{}
+	many := map[string]any{}
+	for _, b := range blocks {
+		block, _ := b.(map[string]any)
+		name := stateupgrade.String(block, "name")
+		if identifiers := stateupgrade.List(block, "identifiers"); identifiers != nil {
+			many[name] = identifiers
+		} else {
+			single[name] = block["identifier"]
+		}
+	}
+
+	relations := map[string]any{}
+	if len(single) > 0 {
+		relations["single_relations"] = single
+	}
+	if len(many) > 0 {
+		relations["many_relations"] = many
+	}
+	return relations
+}
@@ -0,0 +1,16 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/entity"
+)
+
+func TestUpgradeStateV0Blocks(t *testing.T) {
+	acctest.CheckStateUpgrade(t, &entity.EntityResource{}, 0, "testdata/state_v0_blocks.json", "testdata/state_v1_blocks.json")
+}
+
+func TestUpgradeStateV0Current(t *testing.T) {
+	acctest.CheckStateUpgrade(t, &entity.EntityResource{}, 0, "testdata/state_v0_current.json", "testdata/state_v0_current.json")
+}
@@ -0,0 +1,299 @@
+package entity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
+	"github.com/samber/lo"
+)
+
+var arrayItemsTypes = map[string]string{
+	"string_items":  "string",
+	"number_items":  "number",
+	"boolean_items": "boolean",
+	"object_items":  "object",
+}
+
+func validateEntityProperties(properties *EntityPropertiesModel, bp *cli.Blueprint) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	propertiesPath := path.Root("properties")
+	setProperties := make(map[string]bool)
+
+	if properties != nil {
+		for identifier, prop := range properties.StringProps {
+			p := propertiesPath.AtName("string_props").AtMapKey(identifier)
+			setProperties[identifier] = !prop.IsNull()
+			bpProp, ok := blueprintPropertyOfType(p, identifier, "string", bp, &diags)
+			if !ok || prop.IsNull() || prop.IsUnknown() {
+				continue
+			}
+			validateStringProperty(p, prop.ValueString(), bpProp, &diags)
+		}
+
+		for identifier, prop := range properties.NumberProps {
+			p := propertiesPath.AtName("number_props").AtMapKey(identifier)
+			setProperties[identifier] = !prop.IsNull()
+			bpProp, ok := blueprintPropertyOfType(p, identifier, "number", bp, &diags)
+			if !ok || prop.IsNull() || prop.IsUnknown() {
+				continue
+			}
+			validateNumberProperty(p, prop.ValueFloat64(), bpProp, &diags)
+		}
+
+		for identifier, prop := range properties.BooleanProps {
+			p := propertiesPath.AtName("boolean_props").AtMapKey(identifier)
+			setProperties[identifier] = !prop.IsNull()
+			blueprintPropertyOfType(p, identifier, "boolean", bp, &diags)
+		}
+
+		for identifier, prop := range properties.ObjectProps {
+			p := propertiesPath.AtName("object_props").AtMapKey(identifier)
+			setProperties[identifier] = !prop.IsNull()
+			_, ok := blueprintPropertyOfType(p, identifier, "object", bp, &diags)
+			if !ok || prop.IsNull() || prop.IsUnknown() {
+				continue
+			}
+			obj := make(map[string]interface{})
+			if err := json.Unmarshal([]byte(prop.ValueString()), &obj); err != nil {
+				diags.AddAttributeError(p, "Invalid entity property", fmt.Sprintf("property %s must be a JSON object: %s", identifier, err.Error()))
+			}
+		}
+
+		if properties.ArrayProps != nil {
+			arrays := map[string]types.Map{
+				"string_items":  properties.ArrayProps.StringItems,
+				"number_items":  properties.ArrayProps.NumberItems,
+				"boolean_items": properties.ArrayProps.BooleanItems,
+				"object_items":  properties.ArrayProps.ObjectItems,
+			}
+			for _, itemsAttribute := range []string{"string_items", "number_items", "boolean_items", "object_items"} {
+				items := arrays[itemsAttribute]
+				if items.IsNull() || items.IsUnknown() {
+					continue
+				}
+				for identifier, value := range items.Elements() {
+					p := propertiesPath.AtName("array_props").AtName(itemsAttribute).AtMapKey(identifier)
+					setProperties[identifier] = !value.IsNull()
+					bpProp, ok := blueprintPropertyOfType(p, identifier, "array", bp, &diags)
+					if !ok {
+						continue
+					}
+					validateArrayProperty(p, identifier, itemsAttribute, value.(types.List), bpProp, &diags)
+				}
+			}
+		}
+	}
+
+	for _, required := range bp.Schema.Required {
+		bpProp, ok := bp.Schema.Properties[required]
+		if !ok || bpProp.Default != nil || setProperties[required] {
+			continue
+		}
+		diags.AddAttributeError(propertiesPath, "Missing required entity property", fmt.Sprintf("property %s is required by blueprint %s", required, bp.Identifier))
+	}
+
+	return diags
+}
+
+func blueprintPropertyOfType(p path.Path, identifier string, propertyType string, bp *cli.Blueprint, diags *diag.Diagnostics) (cli.BlueprintProperty, bool) {
+	bpProp, ok := bp.Schema.Properties[identifier]
+	if !ok {
+		available := lo.Keys(bp.Schema.Properties)
+		sort.Strings(available)
+		diags.AddAttributeError(p, "Unknown entity property", fmt.Sprintf("property %s doesn't exist in blueprint %s, available properties are: %s", identifier, bp.Identifier, strings.Join(available, ", ")))
+		return bpProp, false
+	}
+	if bpProp.Type != propertyType {
+		diags.AddAttributeError(p, "Invalid entity property type", fmt.Sprintf("property %s is of type %s in blueprint %s, not %s", identifier, bpProp.Type, bp.Identifier, propertyType))
+		return bpProp, false
+	}
+	return bpProp, true
+}
+
+func validateStringProperty(p path.Path, value string, bpProp cli.BlueprintProperty, diags *diag.Diagnostics) {
+	if len(bpProp.Enum) > 0 && !lo.ContainsBy(bpProp.Enum, func(v any) bool { return v == value }) {
+		diags.AddAttributeError(p, "Invalid entity property value", fmt.Sprintf("value %q is not one of the allowed values: %s", value, formatEnum(bpProp.Enum)))
+	}
+	length := utf8.RuneCountInString(value)
+	if bpProp.MinLength != nil && length < *bpProp.MinLength {
+		diags.AddAttributeError(p, "Invalid entity property value", fmt.Sprintf("value %q is shorter than the minimum length of %d", value, *bpProp.MinLength))
+	}
+	if bpProp.MaxLength != nil && length > *bpProp.MaxLength {
+		diags.AddAttributeError(p, "Invalid entity property value", fmt.Sprintf("value %q is longer than the maximum length of %d", value, *bpProp.MaxLength))
+	}
+	if bpProp.Pattern != nil {
+		// Patterns using syntax that Go's regexp doesn't support are left for the API to validate
+		if re, err := regexp.Compile(*bpProp.Pattern); err == nil && !re.MatchString(value) {
+			diags.AddAttributeError(p, "Invalid entity property value", fmt.Sprintf("value %q doesn't match the pattern %s", value, *bpProp.Pattern))
+		}
+	}
+}
+
+func validateNumberProperty(p path.Path, value float64, bpProp cli.BlueprintProperty, diags *diag.Diagnostics) {
+	if len(bpProp.Enum) > 0 && !lo.ContainsBy(bpProp.Enum, func(v any) bool { return v == value }) {
+		diags.AddAttributeError(p, "Invalid entity property value", fmt.Sprintf("value %v is not one of the allowed values: %s", value, formatEnum(bpProp.Enum)))
+	}
+	if bpProp.Minimum != nil && value < *bpProp.Minimum {
+		diags.AddAttributeError(p, "Invalid entity property value", fmt.Sprintf("value %v is lower than the minimum of %v", value, *bpProp.Minimum))
+	}
+	if bpProp.Maximum != nil && value > *bpProp.Maximum {
+		diags.AddAttributeError(p, "Invalid entity property value", fmt.Sprintf("value %v is greater than the maximum of %v", value, *bpProp.Maximum))
+	}
+}
+
+func validateArrayProperty(p path.Path, identifier string, itemsAttribute string, value types.List, bpProp cli.BlueprintProperty, diags *diag.Diagnostics) {
+	itemsType, _ := bpProp.Items["type"].(string)
+	// array without items type is array of string by default
+	if itemsType == "" {
+		itemsType = "string"
+	}
+	if itemsType != arrayItemsTypes[itemsAttribute] {
+		diags.AddAttributeError(p, "Invalid entity property type", fmt.Sprintf("property %s is an array of %s items, it should be set in %s_items", identifier, itemsType, itemsType))
+		return
+	}
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	length := len(value.Elements())
+	if bpProp.MinItems != nil && length < *bpProp.MinItems {
+		diags.AddAttributeError(p, "Invalid entity property value", fmt.Sprintf("property %s has %d items, the minimum is %d", identifier, length, *bpProp.MinItems))
+	}
+	if bpProp.MaxItems != nil && length > *bpProp.MaxItems {
+		diags.AddAttributeError(p, "Invalid entity property value", fmt.Sprintf("property %s has %d items, the maximum is %d", identifier, length, *bpProp.MaxItems))
+	}
+}
+
+func validateEntityRelations(relations types.Object, bp *cli.Blueprint) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	relationsPath := path.Root("relations")
+	setRelations := make(map[string]bool)
+
+	if !relations.IsNull() && !relations.IsUnknown() {
+		for _, attribute := range []string{"single_relations", "many_relations"} {
+			relationsMap, ok := relations.Attributes()[attribute].(types.Map)
+			if !ok || relationsMap.IsNull() || relationsMap.IsUnknown() {
+				continue
+			}
+			many := attribute == "many_relations"
+			for identifier, value := range relationsMap.Elements() {
+				p := relationsPath.AtName(attribute).AtMapKey(identifier)
+				setRelations[identifier] = !value.IsNull()
+				bpRelation, ok := bp.Relations[identifier]
+				if !ok || bpRelation.Target == nil {
+					available := lo.Keys(bp.Relations)
+					sort.Strings(available)
+					diags.AddAttributeError(p, "Unknown entity relation", fmt.Sprintf("relation %s doesn't exist in blueprint %s, available relations are: %s", identifier, bp.Identifier, strings.Join(available, ", ")))
+					continue
+				}
+				relationMany := bpRelation.Many != nil && *bpRelation.Many
+				if relationMany && !many {
+					diags.AddAttributeError(p, "Invalid entity relation", fmt.Sprintf("relation %s to %s is a many relation, it should be set in many_relations", identifier, *bpRelation.Target))
+				}
+				if !relationMany && many {
+					diags.AddAttributeError(p, "Invalid entity relation", fmt.Sprintf("relation %s to %s is a single relation, it should be set in single_relations", identifier, *bpRelation.Target))
+				}
+			}
+		}
+	}
+
+	for identifier, bpRelation := range bp.Relations {
+		if bpRelation.Required != nil && *bpRelation.Required && !setRelations[identifier] {
+			diags.AddAttributeError(relationsPath, "Missing required entity relation", fmt.Sprintf("relation %s is required by blueprint %s", identifier, bp.Identifier))
+		}
+	}
+
+	return diags
+}
+
+// validateRelatedEntities checks that the entities the relations point at exist in the target blueprints, unless they
+// are created by the same apply
+func validateRelatedEntities(ctx context.Context, portClient *cli.PortClient, relations types.Object, bp *cli.Blueprint) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if relations.IsNull() || relations.IsUnknown() {
+		return diags
+	}
+	relationsPath := path.Root("relations")
+	checked := make(map[string]bool)
+
+	for _, attribute := range []string{"single_relations", "many_relations"} {
+		relationsMap, ok := relations.Attributes()[attribute].(types.Map)
+		if !ok || relationsMap.IsNull() || relationsMap.IsUnknown() {
+			continue
+		}
+		for identifier, value := range relationsMap.Elements() {
+			bpRelation, ok := bp.Relations[identifier]
+			if !ok || bpRelation.Target == nil {
+				continue
+			}
+			var related []types.String
+			switch v := value.(type) {
+			case types.String:
+				related = []types.String{v}
+			case types.List:
+				for _, element := range v.Elements() {
+					if s, ok := element.(types.String); ok {
+						related = append(related, s)
+					}
+				}
+			}
+			target := *bpRelation.Target
+			for _, relatedIdentifier := range related {
+				if relatedIdentifier.IsNull() || relatedIdentifier.IsUnknown() {
+					continue
+				}
+				key := target + importid.Separator + relatedIdentifier.ValueString()
+				if checked[key] || portClient.PendingBlueprints.Contains(target) || portClient.PendingEntities.Contains(key) {
+					continue
+				}
+				checked[key] = true
+				_, statusCode, err := portClient.ReadEntity(ctx, relatedIdentifier.ValueString(), target)
+				if err == nil {
+					continue
+				}
+				p := relationsPath.AtName(attribute).AtMapKey(identifier)
+				if statusCode == 404 {
+					diags.AddAttributeError(p, "Unknown related entity", fmt.Sprintf("entity %s doesn't exist in blueprint %s, the target of relation %s", relatedIdentifier.ValueString(), target, identifier))
+					continue
+				}
+				diags.AddAttributeWarning(p, "Failed to read related entity", err.Error())
+			}
+		}
+	}
+
+	return diags
+}
+
+// errorsAsWarnings reports the errors as warnings with the reason they can't fail the plan
+func errorsAsWarnings(diags diag.Diagnostics, reason string) diag.Diagnostics {
+	warnings := diag.Diagnostics{}
+	for _, d := range diags {
+		if d.Severity() != diag.SeverityError {
+			warnings.Append(d)
+			continue
+		}
+		detail := d.Detail() + "\n\n" + reason
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			warnings.AddAttributeWarning(withPath.Path(), d.Summary(), detail)
+			continue
+		}
+		warnings.AddWarning(d.Summary(), detail)
+	}
+	return warnings
+}
+
+func formatEnum(enum []any) string {
+	values := lo.Map(enum, func(v any, _ int) string {
+		return fmt.Sprintf("%v", v)
+	})
+	return strings.Join(values, ", ")
+}
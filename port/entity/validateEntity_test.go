@@ -0,0 +1,257 @@
+package entity
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/porttest"
+	"github.com/samber/lo"
+)
+
+func serviceBlueprint() *cli.Blueprint {
+	return &cli.Blueprint{
+		Identifier: "service",
+		Title:      "Service",
+		Schema: cli.BlueprintSchema{
+			Properties: map[string]cli.BlueprintProperty{
+				"language": {Type: "string", Enum: []any{"go", "python"}},
+				"name":     {Type: "string", MinLength: lo.ToPtr(2), MaxLength: lo.ToPtr(5), Pattern: lo.ToPtr("^[a-z]+$")},
+				"replicas": {Type: "number", Minimum: lo.ToPtr(1.0), Maximum: lo.ToPtr(3.0)},
+				"public":   {Type: "boolean"},
+				"tags":     {Type: "array", MinItems: lo.ToPtr(1)},
+				"ports":    {Type: "array", Items: map[string]any{"type": "number"}},
+				"owner":    {Type: "string", Default: "platform"},
+			},
+			Required: []string{"language", "owner"},
+		},
+		Relations: map[string]cli.Relation{
+			"domain":       {Target: lo.ToPtr("domain"), Required: lo.ToPtr(true)},
+			"dependencies": {Target: lo.ToPtr("service"), Many: lo.ToPtr(true)},
+		},
+	}
+}
+
+func emptyArrayProps() *ArrayPropsModel {
+	return &ArrayPropsModel{
+		StringItems:  types.MapNull(types.ListType{ElemType: types.StringType}),
+		NumberItems:  types.MapNull(types.ListType{ElemType: types.Float64Type}),
+		BooleanItems: types.MapNull(types.ListType{ElemType: types.BoolType}),
+		ObjectItems:  types.MapNull(types.ListType{ElemType: types.StringType}),
+	}
+}
+
+func relationsObject(single map[string]string, many map[string][]string) types.Object {
+	singleValues := lo.MapValues(single, func(v string, _ string) attr.Value { return types.StringValue(v) })
+	manyValues := lo.MapValues(many, func(v []string, _ string) attr.Value {
+		return types.ListValueMust(types.StringType, lo.Map(v, func(s string, _ int) attr.Value { return types.StringValue(s) }))
+	})
+	return types.ObjectValueMust(
+		map[string]attr.Type{
+			"single_relations": types.MapType{ElemType: types.StringType},
+			"many_relations":   types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+		},
+		map[string]attr.Value{
+			"single_relations": types.MapValueMust(types.StringType, singleValues),
+			"many_relations":   types.MapValueMust(types.ListType{ElemType: types.StringType}, manyValues),
+		},
+	)
+}
+
+// errorDetails returns the details of the errors, each with the path it is reported on
+func errorDetails(diags diag.Diagnostics) []string {
+	return lo.FilterMap(diags, func(d diag.Diagnostic, _ int) (string, bool) {
+		if d.Severity() != diag.SeverityError {
+			return "", false
+		}
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			return withPath.Path().String() + ": " + d.Detail(), true
+		}
+		return d.Detail(), true
+	})
+}
+
+func assertErrors(t *testing.T, diags diag.Diagnostics, expected []string) {
+	t.Helper()
+	details := errorDetails(diags)
+	if len(details) != len(expected) {
+		t.Fatalf("expected %d errors, got %d: %s", len(expected), len(details), strings.Join(details, "\n"))
+	}
+	for _, e := range expected {
+		if !lo.SomeBy(details, func(d string) bool { return strings.Contains(d, e) }) {
+			t.Errorf("expected an error containing %q, got: %s", e, strings.Join(details, "\n"))
+		}
+	}
+}
+
+func TestValidateEntityProperties(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties *EntityPropertiesModel
+		expected   []string
+	}{
+		{
+			name: "valid",
+			properties: &EntityPropertiesModel{
+				StringProps:  map[string]types.String{"language": types.StringValue("go"), "name": types.StringValue("api")},
+				NumberProps:  map[string]types.Float64{"replicas": types.Float64Value(2)},
+				BooleanProps: map[string]types.Bool{"public": types.BoolValue(true)},
+				ArrayProps: &ArrayPropsModel{
+					StringItems:  types.MapValueMust(types.ListType{ElemType: types.StringType}, map[string]attr.Value{"tags": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")})}),
+					NumberItems:  types.MapValueMust(types.ListType{ElemType: types.Float64Type}, map[string]attr.Value{"ports": types.ListValueMust(types.Float64Type, []attr.Value{types.Float64Value(80)})}),
+					BooleanItems: types.MapNull(types.ListType{ElemType: types.BoolType}),
+					ObjectItems:  types.MapNull(types.ListType{ElemType: types.StringType}),
+				},
+			},
+		},
+		{
+			name:       "missing required property",
+			properties: &EntityPropertiesModel{ArrayProps: emptyArrayProps()},
+			expected:   []string{`properties: property language is required by blueprint service`},
+		},
+		{
+			name:     "no properties",
+			expected: []string{`property language is required by blueprint service`},
+		},
+		{
+			name: "unknown property and wrong type",
+			properties: &EntityPropertiesModel{
+				StringProps:  map[string]types.String{"language": types.StringValue("go"), "missing": types.StringValue("x")},
+				BooleanProps: map[string]types.Bool{"replicas": types.BoolValue(true)},
+			},
+			expected: []string{
+				`properties.string_props["missing"]: property missing doesn't exist in blueprint service, available properties are: language, name, owner, ports, public, replicas, tags`,
+				`properties.boolean_props["replicas"]: property replicas is of type number in blueprint service, not boolean`,
+			},
+		},
+		{
+			name: "string constraints",
+			properties: &EntityPropertiesModel{
+				StringProps: map[string]types.String{"language": types.StringValue("rust"), "name": types.StringValue("A")},
+			},
+			expected: []string{
+				`value "rust" is not one of the allowed values: go, python`,
+				`value "A" is shorter than the minimum length of 2`,
+				`value "A" doesn't match the pattern ^[a-z]+$`,
+			},
+		},
+		{
+			name: "number constraints",
+			properties: &EntityPropertiesModel{
+				StringProps: map[string]types.String{"language": types.StringValue("go")},
+				NumberProps: map[string]types.Float64{"replicas": types.Float64Value(4)},
+			},
+			expected: []string{`value 4 is greater than the maximum of 3`},
+		},
+		{
+			name: "array constraints",
+			properties: &EntityPropertiesModel{
+				StringProps: map[string]types.String{"language": types.StringValue("go")},
+				ArrayProps: &ArrayPropsModel{
+					StringItems:  types.MapValueMust(types.ListType{ElemType: types.StringType}, map[string]attr.Value{"tags": types.ListValueMust(types.StringType, []attr.Value{}), "ports": types.ListValueMust(types.StringType, []attr.Value{})}),
+					NumberItems:  types.MapNull(types.ListType{ElemType: types.Float64Type}),
+					BooleanItems: types.MapNull(types.ListType{ElemType: types.BoolType}),
+					ObjectItems:  types.MapNull(types.ListType{ElemType: types.StringType}),
+				},
+			},
+			expected: []string{
+				`property tags has 0 items, the minimum is 1`,
+				`property ports is an array of number items, it should be set in number_items`,
+			},
+		},
+		{
+			name: "unknown values are left to the API",
+			properties: &EntityPropertiesModel{
+				StringProps: map[string]types.String{"language": types.StringUnknown(), "name": types.StringUnknown()},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertErrors(t, validateEntityProperties(tt.properties, serviceBlueprint()), tt.expected)
+		})
+	}
+}
+
+func TestValidateEntityRelations(t *testing.T) {
+	tests := []struct {
+		name      string
+		relations types.Object
+		expected  []string
+	}{
+		{
+			name:      "valid",
+			relations: relationsObject(map[string]string{"domain": "payments"}, map[string][]string{"dependencies": {"api"}}),
+		},
+		{
+			name:      "missing required relation",
+			relations: relationsObject(nil, nil),
+			expected:  []string{`relations: relation domain is required by blueprint service`},
+		},
+		{
+			name:      "unknown relation",
+			relations: relationsObject(map[string]string{"domain": "payments", "team": "a"}, nil),
+			expected:  []string{`relations.single_relations["team"]: relation team doesn't exist in blueprint service, available relations are: dependencies, domain`},
+		},
+		{
+			name:      "single and many relations",
+			relations: relationsObject(map[string]string{"dependencies": "api"}, map[string][]string{"domain": {"payments"}}),
+			expected: []string{
+				`relation dependencies to service is a many relation, it should be set in many_relations`,
+				`relation domain to domain is a single relation, it should be set in single_relations`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertErrors(t, validateEntityRelations(tt.relations, serviceBlueprint()), tt.expected)
+		})
+	}
+}
+
+func TestValidateRelatedEntities(t *testing.T) {
+	server := porttest.NewServer()
+	t.Cleanup(server.Close)
+	portClient, err := cli.New(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err = portClient.Authenticate(ctx, server.ClientID, server.ClientSecret); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = portClient.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "domain", Title: "Domain"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = portClient.CreateBlueprint(ctx, &cli.Blueprint{Identifier: "service", Title: "Service"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = portClient.CreateEntity(ctx, &cli.Entity{Identifier: "payments", Title: "Payments", Blueprint: "domain"}, ""); err != nil {
+		t.Fatal(err)
+	}
+	portClient.PendingEntities.Add("service:planned")
+
+	diags := validateRelatedEntities(ctx, portClient, relationsObject(map[string]string{"domain": "payments"}, map[string][]string{"dependencies": {"planned", "missing"}}), serviceBlueprint())
+	assertErrors(t, diags, []string{`relations.many_relations["dependencies"]: entity missing doesn't exist in blueprint service, the target of relation dependencies`})
+
+	// the entities of a blueprint changed in the same apply aren't checked
+	portClient.PendingBlueprints.Add("service")
+	diags = validateRelatedEntities(ctx, portClient, relationsObject(map[string]string{"domain": "missing"}, map[string][]string{"dependencies": {"missing"}}), serviceBlueprint())
+	assertErrors(t, diags, []string{`entity missing doesn't exist in blueprint domain, the target of relation domain`})
+}
+
+func TestErrorsAsWarnings(t *testing.T) {
+	diags := validateEntityProperties(nil, serviceBlueprint())
+	diags.AddWarning("warning", "kept")
+	warnings := errorsAsWarnings(diags, "the blueprint is changed by the same apply")
+	if warnings.HasError() {
+		t.Fatalf("expected no errors, got %v", warnings)
+	}
+	if len(warnings) != 2 || !strings.HasSuffix(warnings[0].Detail(), "\n\nthe blueprint is changed by the same apply") || warnings[1].Detail() != "kept" {
+		t.Errorf("expected the errors as warnings with the reason, got %v", warnings)
+	}
+}
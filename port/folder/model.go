@@ -0,0 +1,12 @@
+package folder
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type FolderModel struct {
+	ID                types.String `tfsdk:"id"`
+	Identifier        types.String `tfsdk:"identifier"`
+	SidebarIdentifier types.String `tfsdk:"sidebar_identifier"`
+	Title             types.String `tfsdk:"title"`
+	Parent            types.String `tfsdk:"parent"`
+	After             types.String `tfsdk:"after"`
+}
@@ -0,0 +1,22 @@
+package folder
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+// folderID is the ID of a folder in the state, <sidebar_identifier>:<identifier>, the import ID of the folder
+func folderID(sidebarIdentifier string, identifier string) string {
+	return fmt.Sprintf("%s:%s", sidebarIdentifier, identifier)
+}
+
+func refreshFolderToState(fm *FolderModel, f *cli.Folder, sidebarIdentifier string) {
+	fm.ID = types.StringValue(folderID(sidebarIdentifier, f.Identifier))
+	fm.Identifier = types.StringValue(f.Identifier)
+	fm.SidebarIdentifier = types.StringValue(sidebarIdentifier)
+	fm.Title = types.StringPointerValue(f.Title)
+	fm.Parent = types.StringPointerValue(f.Parent)
+	fm.After = types.StringPointerValue(f.After)
+}
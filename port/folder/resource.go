@@ -0,0 +1,129 @@
+package folder
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
+)
+
+var _ resource.Resource = &FolderResource{}
+var _ resource.ResourceWithImportState = &FolderResource{}
+
+func NewFolderResource() resource.Resource {
+	return &FolderResource{}
+}
+
+type FolderResource struct {
+	portClient *cli.PortClient
+}
+
+func (r *FolderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+func (r *FolderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (r *FolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// folders of the catalog sidebar can be imported with their identifier alone
+	if !strings.Contains(req.ID, importid.Separator) {
+		req.ID = defaultSidebarIdentifier + importid.Separator + req.ID
+	}
+	importid.Import(ctx, req, resp, "sidebar_identifier", "identifier")
+}
+
+func (r *FolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *FolderModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sidebarIdentifier := state.SidebarIdentifier.ValueString()
+	f, statusCode, err := r.portClient.ReadFolder(ctx, sidebarIdentifier, state.Identifier.ValueString())
+	if err != nil {
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read folder", err.Error())
+		return
+	}
+
+	refreshFolderToState(state, f, sidebarIdentifier)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state *FolderModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.portClient.CreateFolder(ctx, state.SidebarIdentifier.ValueString(), folderToPortBody(state))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create folder", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(folderID(state.SidebarIdentifier.ValueString(), state.Identifier.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state *FolderModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.portClient.UpdateFolder(ctx, state.SidebarIdentifier.ValueString(), state.Identifier.ValueString(), folderToPortBody(state))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update folder", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(folderID(state.SidebarIdentifier.ValueString(), state.Identifier.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *FolderModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statusCode, err := r.portClient.DeleteFolder(ctx, state.SidebarIdentifier.ValueString(), state.Identifier.ValueString())
+	if err != nil {
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to delete folder", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
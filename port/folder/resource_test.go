@@ -0,0 +1,182 @@
+package folder_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func TestAccPortFolderBasic(t *testing.T) {
+	folderIdentifier := utils.GenID()
+	var testAccPortFolderBasic = fmt.Sprintf(`
+resource "port_folder" "engineering" {
+  identifier = "%s"
+  title      = "Engineering"
+}
+`, folderIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccPortFolderBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_folder.engineering", "id", fmt.Sprintf("catalog:%s", folderIdentifier)),
+					resource.TestCheckResourceAttr("port_folder.engineering", "identifier", folderIdentifier),
+					resource.TestCheckResourceAttr("port_folder.engineering", "sidebar_identifier", "catalog"),
+					resource.TestCheckResourceAttr("port_folder.engineering", "title", "Engineering"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortFolderWithBetaFeaturesDisabled(t *testing.T) {
+	folderIdentifier := utils.GenID()
+	var testAccPortFolderBasic = fmt.Sprintf(`
+resource "port_folder" "engineering" {
+  identifier = "%s"
+  title      = "Engineering"
+}
+`, folderIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfigBetaFeaturesDisabled + testAccPortFolderBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_folder.engineering", "id", fmt.Sprintf("catalog:%s", folderIdentifier)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortFolderTree(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	parentIdentifier := utils.GenID()
+	childIdentifier := utils.GenID()
+	siblingIdentifier := utils.GenID()
+	pageIdentifier := utils.GenID()
+	var testAccPortFolderTree = fmt.Sprintf(`
+resource "port_blueprint" "microservice" {
+  title      = "TF test microservice"
+  icon       = "Terraform"
+  identifier = "%s"
+}
+
+resource "port_folder" "parent" {
+  identifier = "%s"
+  title      = "Parent"
+}
+
+resource "port_folder" "child" {
+  identifier = "%s"
+  title      = "Child"
+  parent     = port_folder.parent.identifier
+}
+
+resource "port_folder" "sibling" {
+  identifier = "%s"
+  title      = "Sibling"
+  parent     = port_folder.parent.identifier
+  after      = port_folder.child.identifier
+}
+
+resource "port_page" "microservices" {
+  identifier = "%s"
+  title      = "Microservices"
+  icon       = "Microservice"
+  blueprint  = port_blueprint.microservice.identifier
+  type       = "blueprint-entities"
+  parent     = port_folder.child.identifier
+  widgets    = [
+    jsonencode(
+      {
+        "id" : "microservicesTable",
+        "type" : "table-entities-explorer",
+        "dataset" : {
+          "combinator" : "and",
+          "rules" : [
+            {
+              "operator" : "=",
+              "property" : "$blueprint",
+              "value" : "{{blueprint}}"
+            }
+          ]
+        }
+      }
+    )
+  ]
+}
+`, blueprintIdentifier, parentIdentifier, childIdentifier, siblingIdentifier, pageIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccPortFolderTree,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_folder.child", "parent", parentIdentifier),
+					resource.TestCheckResourceAttr("port_folder.sibling", "parent", parentIdentifier),
+					resource.TestCheckResourceAttr("port_folder.sibling", "after", childIdentifier),
+					resource.TestCheckResourceAttr("port_page.microservices", "parent", childIdentifier),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortFolderUpdateAndImport(t *testing.T) {
+	folderIdentifier := utils.GenID()
+	var testAccPortFolderCreate = fmt.Sprintf(`
+resource "port_folder" "engineering" {
+  identifier = "%s"
+  title      = "Engineering"
+}
+`, folderIdentifier)
+	var testAccPortFolderUpdate = fmt.Sprintf(`
+resource "port_folder" "engineering" {
+  identifier = "%s"
+  title      = "Engineering Updated"
+}
+`, folderIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccPortFolderCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_folder.engineering", "title", "Engineering"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccPortFolderUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_folder.engineering", "title", "Engineering Updated"),
+				),
+			},
+			{
+				ResourceName:      "port_folder.engineering",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     folderIdentifier,
+			},
+			{
+				ResourceName:      "port_folder.engineering",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("catalog:%s", folderIdentifier),
+			},
+		},
+	})
+}
@@ -0,0 +1,99 @@
+package folder
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+const defaultSidebarIdentifier = "catalog"
+
+func FolderSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the folder",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"sidebar_identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the sidebar the folder is in, default is the catalog sidebar",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(defaultSidebarIdentifier),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"title": schema.StringAttribute{
+			MarkdownDescription: "The title of the folder",
+			Optional:            true,
+		},
+		"parent": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the parent folder, default is the root of the sidebar",
+			Optional:            true,
+		},
+		"after": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the page/folder after which the folder should be placed",
+			Optional:            true,
+		},
+	}
+}
+
+func (r *FolderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: FolderResourceMarkdownDescription,
+		Attributes:          FolderSchema(),
+	}
+}
+
+var FolderResourceMarkdownDescription = `
+
+# Folder resource
+
+This resource allows you to manage the folders of the catalog sidebar, folders can be nested using the ` + "`parent`" + ` attribute and ordered using the ` + "`after`" + ` attribute.
+
+Pages are placed in a folder by referencing it in their ` + "`parent`" + ` attribute.
+
+## Example Usage
+
+` + "```hcl" + `
+
+resource "port_folder" "engineering" {
+  identifier = "engineering"
+  title      = "Engineering"
+}
+
+resource "port_folder" "services" {
+  identifier = "services"
+  title      = "Services"
+  parent     = port_folder.engineering.identifier
+}
+
+resource "port_folder" "infrastructure" {
+  identifier = "infrastructure"
+  title      = "Infrastructure"
+  parent     = port_folder.engineering.identifier
+  after      = port_folder.services.identifier
+}
+
+resource "port_page" "microservices" {
+  identifier = "microservices"
+  title      = "Microservices"
+  type       = "blueprint-entities"
+  icon       = "Microservice"
+  blueprint  = port_blueprint.microservice.identifier
+  parent     = port_folder.services.identifier
+}
+
+` + "```" + `
+
+`
@@ -0,0 +1,59 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
+)
+
+var _ function.Function = &EntityRefFunction{}
+
+func NewEntityRefFunction() function.Function {
+	return &EntityRefFunction{}
+}
+
+type EntityRefFunction struct{}
+
+func (f *EntityRefFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "entity_ref"
+}
+
+func (f *EntityRefFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build the ID of an entity",
+		MarkdownDescription: "Returns the ID of an entity, `<blueprint>:<identifier>`, which is the `id` of its `port_entity` resource and its import ID. " +
+			"For example, `provider::port::entity_ref(\"microservice\", \"checkout\")` returns `microservice:checkout`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "blueprint",
+				MarkdownDescription: "The identifier of the blueprint of the entity",
+			},
+			function.StringParameter{
+				Name:                "identifier",
+				MarkdownDescription: "The identifier of the entity",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *EntityRefFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var blueprint, identifier string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &blueprint, &identifier))
+	if resp.Error != nil {
+		return
+	}
+
+	if blueprint == "" || strings.Contains(blueprint, importid.Separator) {
+		resp.Error = function.NewArgumentFuncError(0, "the blueprint identifier must not be empty or contain a colon")
+		return
+	}
+	if identifier == "" {
+		resp.Error = function.NewArgumentFuncError(1, "the entity identifier must not be empty")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, blueprint+importid.Separator+identifier))
+}
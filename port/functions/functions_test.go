@@ -0,0 +1,172 @@
+package functions_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/functions"
+)
+
+// run runs the function with the arguments, and returns its string result or its error
+func run(t *testing.T, f function.Function, arguments ...attr.Value) (string, *function.FuncError) {
+	t.Helper()
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+func stringList(values ...string) attr.Value {
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func TestJqString(t *testing.T) {
+	for value, expected := range map[string]string{
+		"my-string":          `"my-string"`,
+		"":                   `""`,
+		`say "hi"`:           `"say \"hi\""`,
+		`\(.title)`:          `"\\(.title)"`,
+		"<b>line\nbreak</b>": `"<b>line\nbreak</b>"`,
+		"owner's & team's":   `"owner's & team's"`,
+		"unicode ✓ éléphant": `"unicode ✓ éléphant"`,
+	} {
+		result, err := run(t, functions.NewJqStringFunction(), types.StringValue(value))
+		if err != nil {
+			t.Fatalf("jq_string(%q) failed: %s", value, err)
+		}
+		if result != expected {
+			t.Errorf("jq_string(%q): expected %s, got %s", value, expected, result)
+		}
+	}
+}
+
+func TestJqPath(t *testing.T) {
+	for _, tc := range []struct {
+		keys     []string
+		expected string
+	}{
+		{nil, "."},
+		{[]string{"title"}, ".title"},
+		{[]string{"metadata", "labels", "app.kubernetes.io/name"}, `.metadata.labels["app.kubernetes.io/name"]`},
+		{[]string{"my-key", "nested"}, `.["my-key"].nested`},
+		{[]string{"_private", "1st"}, `._private["1st"]`},
+	} {
+		result, err := run(t, functions.NewJqPathFunction(), stringList(tc.keys...))
+		if err != nil {
+			t.Fatalf("jq_path(%q) failed: %s", tc.keys, err)
+		}
+		if result != tc.expected {
+			t.Errorf("jq_path(%q): expected %s, got %s", tc.keys, tc.expected, result)
+		}
+	}
+
+	if _, err := run(t, functions.NewJqPathFunction(), stringList("metadata", "")); err == nil {
+		t.Error("expected jq_path to fail on an empty key")
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	for value, expected := range map[string]string{
+		"my-service":                 "my-service",
+		"My Service (Prod)":          "my-service-prod",
+		"  Payments   API  ":         "payments-api",
+		"Café Crème":                 "cafe-creme",
+		"team@example.com":           "team@example.com",
+		"github.com/port-labs/ocean": "github.com/port-labs/ocean",
+		"snake_case and k=v":         "snake_case-and-k=v",
+		"!!!Leading and trailing!!!": "leading-and-trailing",
+		strings.Repeat("ab ", 50):    strings.Repeat("ab-", 33) + "a",
+		"über/straße":                "uber/stra-e",
+		"version 1.2.3+build":        "version-1.2.3+build",
+		"emoji 🚀 launch":             "emoji-launch",
+	} {
+		result, err := run(t, functions.NewIdentifierFunction(), types.StringValue(value))
+		if err != nil {
+			t.Fatalf("identifier(%q) failed: %s", value, err)
+		}
+		if result != expected {
+			t.Errorf("identifier(%q): expected %s, got %s", value, expected, result)
+		}
+	}
+
+	if _, err := run(t, functions.NewIdentifierFunction(), types.StringValue("🚀 !")); err == nil {
+		t.Error("expected identifier to fail on a string without identifier characters")
+	}
+}
+
+func TestEntityRef(t *testing.T) {
+	result, err := run(t, functions.NewEntityRefFunction(), types.StringValue("microservice"), types.StringValue("checkout:v2"))
+	if err != nil {
+		t.Fatalf("entity_ref failed: %s", err)
+	}
+	if expected := "microservice:checkout:v2"; result != expected {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+
+	for _, arguments := range [][]attr.Value{
+		{types.StringValue(""), types.StringValue("checkout")},
+		{types.StringValue("micro:service"), types.StringValue("checkout")},
+		{types.StringValue("microservice"), types.StringValue("")},
+	} {
+		if _, err := run(t, functions.NewEntityRefFunction(), arguments...); err == nil {
+			t.Errorf("expected entity_ref(%s, %s) to fail", arguments[0], arguments[1])
+		}
+	}
+}
+
+func TestAccPortFunctions(t *testing.T) {
+	suffix := utils.GenID()
+	var testAccFunctionsConfig = fmt.Sprintf(`
+	resource "port_blueprint" "service" {
+		title      = "My Service %[1]s"
+		icon       = "Terraform"
+		identifier = provider::port::identifier("My Service %[1]s")
+	}
+
+	output "entity_ref" {
+		value = provider::port::entity_ref(port_blueprint.service.identifier, "checkout")
+	}
+
+	output "jq_path" {
+		value = provider::port::jq_path(["metadata", "app.kubernetes.io/name"])
+	}
+
+	output "jq_string" {
+		value = provider::port::jq_string("say \"hi\"")
+	}`, suffix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		// provider functions are called by Terraform 1.8 and later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccFunctionsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_blueprint.service", "identifier", "my-service-"+suffix),
+					resource.TestCheckOutput("entity_ref", fmt.Sprintf("my-service-%s:checkout", suffix)),
+					resource.TestCheckOutput("jq_path", `.metadata["app.kubernetes.io/name"]`),
+					resource.TestCheckOutput("jq_string", `"say \"hi\""`),
+				),
+			},
+		},
+	})
+}
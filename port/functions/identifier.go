@@ -0,0 +1,77 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"golang.org/x/text/unicode/norm"
+)
+
+const maxIdentifierLength = 100
+
+// invalidIdentifierCharsRegex matches the runs of characters that Port doesn't allow in identifiers
+var invalidIdentifierCharsRegex = regexp.MustCompile(`[^a-z0-9@_.+:\\/=-]+`)
+
+var _ function.Function = &IdentifierFunction{}
+
+func NewIdentifierFunction() function.Function {
+	return &IdentifierFunction{}
+}
+
+type IdentifierFunction struct{}
+
+func (f *IdentifierFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "identifier"
+}
+
+func (f *IdentifierFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a string to a valid Port identifier",
+		MarkdownDescription: fmt.Sprintf("Returns a slug of the given string that follows Port's identifier rules: the string is lower cased, "+
+			"accents are removed, every run of characters that identifiers can't contain is replaced with a `-` and the identifier is "+
+			"truncated to %d characters. For example, `provider::port::identifier(\"My Service (Prod)\")` returns `my-service-prod`.", maxIdentifierLength),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "The string to convert, e.g. the title of the object",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *IdentifierFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	identifier := toIdentifier(value)
+	if identifier == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q has no characters that can be used in an identifier", value))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, identifier))
+}
+
+func toIdentifier(value string) string {
+	// decompose the accented characters, so that dropping their marks leaves the base character
+	var b strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(value)) {
+		if !unicode.Is(unicode.Mn, r) {
+			b.WriteRune(r)
+		}
+	}
+
+	identifier := strings.Trim(invalidIdentifierCharsRegex.ReplaceAllString(b.String(), "-"), "-")
+	if len(identifier) > maxIdentifierLength {
+		identifier = strings.TrimRight(identifier[:maxIdentifierLength], "-")
+	}
+	return identifier
+}
@@ -0,0 +1,77 @@
+package functions
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// jqIdentifierRegex matches the keys that jq can access with .key, the other keys are accessed with ["key"]
+var jqIdentifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+var _ function.Function = &JqPathFunction{}
+
+func NewJqPathFunction() function.Function {
+	return &JqPathFunction{}
+}
+
+type JqPathFunction struct{}
+
+func (f *JqPathFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jq_path"
+}
+
+func (f *JqPathFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a jq path expression from a list of keys",
+		MarkdownDescription: "Returns a jq expression that accesses the value at the given keys, quoting the keys that aren't valid jq identifiers. " +
+			"For example, `provider::port::jq_path([\"metadata\", \"labels\", \"app.kubernetes.io/name\"])` returns `.metadata.labels[\"app.kubernetes.io/name\"]`, " +
+			"and an empty list returns `.`.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "keys",
+				MarkdownDescription: "The keys of the path, from the outermost object to the value",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *JqPathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var keys []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &keys))
+	if resp.Error != nil {
+		return
+	}
+
+	for _, key := range keys {
+		if key == "" {
+			resp.Error = function.NewArgumentFuncError(0, "the keys of a jq path must not be empty")
+			return
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, jqPath(keys)))
+}
+
+func jqPath(keys []string) string {
+	if len(keys) == 0 {
+		return "."
+	}
+	var b strings.Builder
+	for i, key := range keys {
+		switch {
+		case jqIdentifierRegex.MatchString(key):
+			b.WriteString("." + key)
+		case i == 0:
+			b.WriteString(".[" + jqString(key) + "]")
+		default:
+			b.WriteString("[" + jqString(key) + "]")
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,58 @@
+package functions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &JqStringFunction{}
+
+func NewJqStringFunction() function.Function {
+	return &JqStringFunction{}
+}
+
+type JqStringFunction struct{}
+
+func (f *JqStringFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jq_string"
+}
+
+func (f *JqStringFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Quote a string as a jq string literal",
+		MarkdownDescription: "Returns a jq expression that evaluates to the given string, for the attributes whose values are jq expressions, " +
+			"such as the mappings of `port_integration` and `port_webhook`. " +
+			"For example, `provider::port::jq_string(\"my-service\")` returns `\"my-service\"`, including the double quotes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "The string the jq expression evaluates to",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *JqStringFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, jqString(value)))
+}
+
+// jqString quotes a string as a jq string literal, jq string literals are JSON strings, except that "\(" starts an
+// interpolation, which JSON escapes as "\\("
+func jqString(value string) string {
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	// encoding a string can't fail
+	_ = encoder.Encode(value)
+	return string(bytes.TrimSuffix(b.Bytes(), []byte("\n")))
+}
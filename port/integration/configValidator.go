@@ -0,0 +1,104 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/validators"
+)
+
+var _ validator.String = configJqValidator{}
+
+// configJqValidator validates the jq expressions of the integration config mappings, the config itself is a raw
+// JSON string, so the location of each expression inside the config is reported in the error
+type configJqValidator struct{}
+
+func (v configJqValidator) Description(ctx context.Context) string {
+	return "the jq expressions of the config mappings must be valid"
+}
+
+func (v configJqValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v configJqValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var config map[string]any
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &config); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid integration config", err.Error())
+		return
+	}
+
+	for _, expression := range configJqExpressions(config) {
+		if err := validators.ValidateJq(expression.value); err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid jq expression", fmt.Sprintf("%s: %s", expression.location, err.Error()))
+		}
+	}
+}
+
+type configJqExpression struct {
+	location string
+	value    string
+}
+
+// configJqExpressions collects the jq expressions of the config: the selector query, the items to parse and the
+// entity mappings of each resource
+func configJqExpressions(config map[string]any) []configJqExpression {
+	var expressions []configJqExpression
+	add := func(location string, value any) {
+		s, ok := value.(string)
+		if !ok {
+			return
+		}
+		expressions = append(expressions, configJqExpression{location: location, value: s})
+	}
+
+	resources, _ := config["resources"].([]any)
+	for i, r := range resources {
+		resource, _ := r.(map[string]any)
+		resourceLocation := fmt.Sprintf("resources[%d]", i)
+
+		if selector, ok := resource["selector"].(map[string]any); ok {
+			add(resourceLocation+".selector.query", selector["query"])
+		}
+
+		port, _ := resource["port"].(map[string]any)
+		add(resourceLocation+".port.itemsToParse", port["itemsToParse"])
+
+		entity, _ := port["entity"].(map[string]any)
+		var mappings []any
+		switch m := entity["mappings"].(type) {
+		case []any:
+			mappings = m
+		case map[string]any:
+			mappings = []any{m}
+		}
+
+		for j, m := range mappings {
+			mapping, _ := m.(map[string]any)
+			mappingLocation := fmt.Sprintf("%s.port.entity.mappings[%d]", resourceLocation, j)
+			for _, field := range []string{"identifier", "title", "blueprint", "icon", "team"} {
+				add(mappingLocation+"."+field, mapping[field])
+			}
+			for _, field := range []string{"properties", "relations"} {
+				values, _ := mapping[field].(map[string]any)
+				keys := make([]string, 0, len(values))
+				for k := range values {
+					keys = append(keys, k)
+				}
+				sort.Strings(keys)
+				for _, k := range keys {
+					add(fmt.Sprintf("%s.%s.%s", mappingLocation, field, k), values[k])
+				}
+			}
+		}
+	}
+
+	return expressions
+}
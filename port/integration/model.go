@@ -1,6 +1,9 @@
 package integration
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+)
 
 type WebhookChangelogDestinationModel struct {
 	Url   types.String `tfsdk:"url"`
@@ -13,7 +16,7 @@ type IntegrationModel struct {
 	InstallationAppType         types.String                      `tfsdk:"installation_app_type"`
 	Title                       types.String                      `tfsdk:"title"`
 	Version                     types.String                      `tfsdk:"version"`
-	Config                      types.String                      `tfsdk:"config"`
+	Config                      jsontypes.Normalized              `tfsdk:"config"`
 	KafkaChangelogDestination   types.Object                      `tfsdk:"kafka_changelog_destination"`
 	WebhookChangelogDestination *WebhookChangelogDestinationModel `tfsdk:"webhook_changelog_destination"`
 }
@@ -16,7 +16,7 @@ func refreshIntegrationState(state *IntegrationModel, a *cli.Integration, integr
 	state.Version = types.StringPointerValue(a.Version)
 
 	if a.Config != nil {
-		config, _ := utils.GoObjectToTerraformString(a.Config)
+		config, _ := utils.GoObjectToTerraformJson(a.Config)
 		state.Config = config
 	}
 	if a.ChangelogDestination != nil {
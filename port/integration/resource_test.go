@@ -2,7 +2,7 @@ package integration_test
 
 import (
 	"fmt"
-	"os"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -90,10 +90,6 @@ func createIntegrationWithWebHook(
 func TestPortIntegrationBasic(t *testing.T) {
 	integrationIdentifier := utils.GenID()
 	installationAppType := "kafka"
-	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
-	if err != nil {
-		t.Fatal(err)
-	}
 	var testPortIntegrationResourceBasic = createIntegration(integrationIdentifier, installationAppType)
 
 	var testAccBaseIntegrationUpdate = strings.Replace(testPortIntegrationResourceBasic, "1.33.7", "1.33.8", -1)
@@ -128,10 +124,6 @@ func TestPortIntegrationBasic(t *testing.T) {
 func TestPortIntegrationPatchTitleNull(t *testing.T) {
 	integrationIdentifier := utils.GenID()
 	installationAppType := "kafka"
-	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
-	if err != nil {
-		t.Fatal(err)
-	}
 	var testPortIntegrationResourceBasic = createIntegration(integrationIdentifier, installationAppType)
 
 	var testAccBaseIntegrationUpdate = strings.Replace(testPortIntegrationResourceBasic, "\"my-kafka-cluster\"", "null", -1)
@@ -166,10 +158,6 @@ func TestPortIntegrationPatchTitleNull(t *testing.T) {
 
 func TestPortIntegrationWithWebhook(t *testing.T) {
 	integrationIdentifier := utils.GenID()
-	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
-	if err != nil {
-		t.Fatal(err)
-	}
 	var testPortIntegrationResourceBasic = createIntegrationWithWebHook(integrationIdentifier, "kafka")
 
 	resource.Test(t, resource.TestCase{
@@ -215,3 +203,19 @@ func TestPortIntegrationImport(t *testing.T) {
 		},
 	})
 }
+
+func TestPortIntegrationInvalidJqMapping(t *testing.T) {
+	integrationIdentifier := utils.GenID()
+	var testPortIntegrationResourceInvalid = strings.Replace(createIntegration(integrationIdentifier, "kafka"), `title      = ".title"`, `title      = ".title | "`, 1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testPortIntegrationResourceInvalid,
+				ExpectError: regexp.MustCompile(`resources\[0\]\.port\.entity\.mappings\[0\]\.title`),
+			},
+		},
+	})
+}
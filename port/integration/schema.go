@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 )
 
 func IntegrationSchema() map[string]schema.Attribute {
@@ -29,6 +31,10 @@ func IntegrationSchema() map[string]schema.Attribute {
 		"config": schema.StringAttribute{
 			MarkdownDescription: "Integration Config Raw JSON string (use `jsonencode`)",
 			Optional:            true,
+			CustomType:          jsontypes.NormalizedType{},
+			Validators: []validator.String{
+				configJqValidator{},
+			},
 		},
 		"webhook_changelog_destination": schema.SingleNestedAttribute{
 			MarkdownDescription: "The webhook changelog destination of the integration",
@@ -104,4 +110,5 @@ resource "port_integration" "my_custom_integration" {
 ### NOTICE:
 
 The following config properties (` + "`selector.query|entity.mappings.*`" + `) are jq expressions, which means that you need to input either a valid jq expression (E.g ` + "`.title`" + `), or if you want a string value, a qouted escaped string val (E.g ` + "`'my-string'`" + `).
+The ` + "`provider::port::jq_string`" + ` and ` + "`provider::port::jq_path`" + ` functions build those expressions, e.g. ` + "`blueprint = provider::port::jq_string(port_blueprint.microservice.identifier)`" + ` (requires Terraform 1.8 or later).
 `
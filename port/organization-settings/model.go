@@ -0,0 +1,28 @@
+package organization_settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AnnouncementModel struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Content types.String `tfsdk:"content"`
+	Link    types.String `tfsdk:"link"`
+}
+
+var announcementAttrTypes = map[string]attr.Type{
+	"enabled": types.BoolType,
+	"content": types.StringType,
+	"link":    types.StringType,
+}
+
+type OrganizationSettingsModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	PortalTitle      types.String `tfsdk:"portal_title"`
+	PortalIcon       types.String `tfsdk:"portal_icon"`
+	Announcement     types.Object `tfsdk:"announcement"`
+	HiddenBlueprints types.Set    `tfsdk:"hidden_blueprints"`
+	FeatureToggles   types.Map    `tfsdk:"feature_toggles"`
+}
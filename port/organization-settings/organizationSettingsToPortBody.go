@@ -0,0 +1,48 @@
+package organization_settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+// organizationSettingsToPortBody converts the settings that are set in the plan, unknown values are settings that
+// aren't managed by terraform, so they are left out of the patch
+func organizationSettingsToPortBody(ctx context.Context, state *OrganizationSettingsModel) (*cli.OrganizationSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	settings := &cli.OrganizationSettings{}
+
+	if !state.PortalTitle.IsUnknown() {
+		settings.PortalTitle = state.PortalTitle.ValueStringPointer()
+	}
+
+	if !state.PortalIcon.IsUnknown() {
+		settings.PortalIcon = state.PortalIcon.ValueStringPointer()
+	}
+
+	if !state.Announcement.IsUnknown() && !state.Announcement.IsNull() {
+		var announcement AnnouncementModel
+		diags.Append(state.Announcement.As(ctx, &announcement, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		settings.Announcement = &cli.OrganizationAnnouncement{
+			Enabled: announcement.Enabled.ValueBoolPointer(),
+			Content: announcement.Content.ValueStringPointer(),
+			Link:    announcement.Link.ValueStringPointer(),
+		}
+	}
+
+	if !state.HiddenBlueprints.IsUnknown() && !state.HiddenBlueprints.IsNull() {
+		hiddenBlueprints := []string{}
+		diags.Append(state.HiddenBlueprints.ElementsAs(ctx, &hiddenBlueprints, false)...)
+		settings.HiddenBlueprints = &hiddenBlueprints
+	}
+
+	if !state.FeatureToggles.IsUnknown() && !state.FeatureToggles.IsNull() {
+		featureToggles := map[string]bool{}
+		diags.Append(state.FeatureToggles.ElementsAs(ctx, &featureToggles, false)...)
+		settings.FeatureToggles = &featureToggles
+	}
+
+	return settings, diags
+}
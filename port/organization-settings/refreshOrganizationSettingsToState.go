@@ -0,0 +1,56 @@
+package organization_settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+func refreshOrganizationSettingsToState(ctx context.Context, state *OrganizationSettingsModel, o *cli.Organization) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(o.Id)
+	if o.Id == "" {
+		state.ID = types.StringValue(o.Name)
+	}
+	state.Name = types.StringValue(o.Name)
+
+	settings := o.Settings
+	if settings == nil {
+		settings = &cli.OrganizationSettings{}
+	}
+
+	state.PortalTitle = types.StringPointerValue(settings.PortalTitle)
+	state.PortalIcon = types.StringPointerValue(settings.PortalIcon)
+
+	var d diag.Diagnostics
+
+	if settings.Announcement != nil {
+		state.Announcement, d = types.ObjectValueFrom(ctx, announcementAttrTypes, AnnouncementModel{
+			Enabled: types.BoolPointerValue(settings.Announcement.Enabled),
+			Content: types.StringPointerValue(settings.Announcement.Content),
+			Link:    types.StringPointerValue(settings.Announcement.Link),
+		})
+		diags.Append(d...)
+	} else {
+		state.Announcement = types.ObjectNull(announcementAttrTypes)
+	}
+
+	hiddenBlueprints := []string{}
+	if settings.HiddenBlueprints != nil {
+		hiddenBlueprints = *settings.HiddenBlueprints
+	}
+	state.HiddenBlueprints, d = types.SetValueFrom(ctx, types.StringType, hiddenBlueprints)
+	diags.Append(d...)
+
+	featureToggles := map[string]bool{}
+	if settings.FeatureToggles != nil {
+		featureToggles = *settings.FeatureToggles
+	}
+	state.FeatureToggles, d = types.MapValueFrom(ctx, types.BoolType, featureToggles)
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,131 @@
+package organization_settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+var _ resource.Resource = &OrganizationSettingsResource{}
+var _ resource.ResourceWithImportState = &OrganizationSettingsResource{}
+
+func NewOrganizationSettingsResource() resource.Resource {
+	return &OrganizationSettingsResource{}
+}
+
+type OrganizationSettingsResource struct {
+	portClient *cli.PortClient
+}
+
+func (r *OrganizationSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_settings"
+}
+
+func (r *OrganizationSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (r *OrganizationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// the organization is a singleton, so the import ID is only kept until the next read
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func (r *OrganizationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *OrganizationSettingsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, _, err := r.portClient.ReadOrganization(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read organization", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(refreshOrganizationSettingsToState(ctx, state, o)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OrganizationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state *OrganizationSettingsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the organization settings always exist, so creating the resource adopts them and patches the configured settings
+	r.updateSettings(ctx, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OrganizationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state *OrganizationSettingsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateSettings(ctx, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OrganizationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *OrganizationSettingsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// organization settings are not deletable, the organization keeps its current settings and the resource is only
+	// removed from the state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *OrganizationSettingsResource) updateSettings(ctx context.Context, state *OrganizationSettingsModel, diags *diag.Diagnostics) {
+	settings, d := organizationSettingsToPortBody(ctx, state)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	_, err := r.portClient.UpdateOrganizationSettings(ctx, settings)
+	if err != nil {
+		diags.AddError("failed to update organization settings", err.Error())
+		return
+	}
+
+	// the settings that aren't managed by terraform are only known after reading the organization
+	o, _, err := r.portClient.ReadOrganization(ctx)
+	if err != nil {
+		diags.AddError("failed to read organization", err.Error())
+		return
+	}
+
+	diags.Append(refreshOrganizationSettingsToState(ctx, state, o)...)
+}
@@ -0,0 +1,87 @@
+package organization_settings_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func TestAccPortOrganizationSettingsBasic(t *testing.T) {
+	content := fmt.Sprintf("TF test announcement %s", utils.GenID())
+	var testAccConfigCreate = fmt.Sprintf(`
+	resource "port_organization_settings" "settings" {
+		announcement = {
+			enabled = false
+			content = "%s"
+		}
+	}
+`, content)
+
+	var testAccConfigUpdate = fmt.Sprintf(`
+	resource "port_organization_settings" "settings" {
+		announcement = {
+			enabled = false
+			content = "%s updated"
+			link    = "https://example.com"
+		}
+	}
+`, content)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("port_organization_settings.settings", "id"),
+					resource.TestCheckResourceAttrSet("port_organization_settings.settings", "name"),
+					resource.TestCheckResourceAttr("port_organization_settings.settings", "announcement.enabled", "false"),
+					resource.TestCheckResourceAttr("port_organization_settings.settings", "announcement.content", content),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_organization_settings.settings", "announcement.content", fmt.Sprintf("%s updated", content)),
+					resource.TestCheckResourceAttr("port_organization_settings.settings", "announcement.link", "https://example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortOrganizationSettingsImport(t *testing.T) {
+	var testAccConfigCreate = `
+	resource "port_organization_settings" "settings" {
+		announcement = {
+			enabled = false
+		}
+	}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_organization_settings.settings", "announcement.enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "port_organization_settings.settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["port_organization_settings.settings"].Primary.ID, nil
+				},
+			},
+		},
+	})
+}
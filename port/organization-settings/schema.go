@@ -0,0 +1,139 @@
+package organization_settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func OrganizationSettingsSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the organization",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"portal_title": schema.StringAttribute{
+			MarkdownDescription: "The title of the portal",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"portal_icon": schema.StringAttribute{
+			MarkdownDescription: "The URL of the logo of the portal",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"announcement": schema.SingleNestedAttribute{
+			MarkdownDescription: "The announcement banner of the portal",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.UseStateForUnknown(),
+			},
+			Attributes: map[string]schema.Attribute{
+				"enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether the announcement banner is shown",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"content": schema.StringAttribute{
+					MarkdownDescription: "The content of the announcement",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"link": schema.StringAttribute{
+					MarkdownDescription: "The link of the announcement",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+		},
+		"hidden_blueprints": schema.SetAttribute{
+			MarkdownDescription: "The identifiers of the blueprints that are hidden from the catalog",
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"feature_toggles": schema.MapAttribute{
+			MarkdownDescription: "The default feature toggles of the organization",
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.BoolType,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *OrganizationSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: OrganizationSettingsResourceMarkdownDescription,
+		Attributes:          OrganizationSettingsSchema(),
+	}
+}
+
+var OrganizationSettingsResourceMarkdownDescription = `
+
+# Organization Settings
+
+This resource allows you to manage the settings of your Port organization, such as the portal title and logo, the announcement banner and the default feature toggles.
+
+The organization settings always exist, so there should be only one ` + "`port_organization_settings`" + ` resource per organization:
+
+- Creating the resource adopts the existing settings and updates the settings that are set in the resource.
+- Settings that aren't set in the resource, including the attributes of the ` + "`announcement`" + `, are left as they are in Port.
+- Destroying the resource only removes it from the Terraform state, the settings of the organization are not changed.
+
+## Example Usage
+
+` + "```hcl" + `
+
+resource "port_organization_settings" "settings" {
+  portal_title = "Acme Developer Portal"
+  portal_icon  = "https://example.com/logo.png"
+  announcement = {
+    enabled = true
+    content = "Scheduled maintenance on Sunday"
+    link    = "https://status.example.com"
+  }
+  hidden_blueprints = ["_user", "_team"]
+}
+
+` + "```" + `
+
+`
@@ -2,7 +2,6 @@ package page_permissions_test
 
 import (
 	"fmt"
-	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -53,10 +52,6 @@ resource "port_page" "microservice_dashboard_page" {
 
 func TestAccPortPagePermissionsBasic(t *testing.T) {
 	pageIdentifier := utils.GenID()
-	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
-	if err != nil {
-		t.Fatal(err)
-	}
 	var testAccPortPageResourceBasic = createPage(pageIdentifier)
 
 	var testAccBasePagePermissionsConfigUpdate = `
@@ -77,7 +72,7 @@ func TestAccPortPagePermissionsBasic(t *testing.T) {
 		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPortPageResourceBasic + testAccBasePagePermissionsConfigUpdate,
+				Config: acctest.ProviderConfig + testAccPortPageResourceBasic + testAccBasePagePermissionsConfigUpdate,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "identifier", pageIdentifier),
 					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "title", "dashboards"),
@@ -97,10 +92,6 @@ func TestAccPortPagePermissionsBasic(t *testing.T) {
 
 func TestAccPortPagePermissionsUpdateWithUsers(t *testing.T) {
 	pageIdentifier := utils.GenID()
-	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
-	if err != nil {
-		t.Fatal(err)
-	}
 	var testAccPortPageResourceBasic = createPage(pageIdentifier)
 
 	teamName := utils.GenID()
@@ -129,7 +120,7 @@ func TestAccPortPagePermissionsUpdateWithUsers(t *testing.T) {
 		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPortPageResourceBasic + testAccBasePagePermissionsConfigUpdate,
+				Config: acctest.ProviderConfig + testAccPortPageResourceBasic + testAccBasePagePermissionsConfigUpdate,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "identifier", pageIdentifier),
 					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "title", "dashboards"),
@@ -150,10 +141,6 @@ func TestAccPortPagePermissionsUpdateWithUsers(t *testing.T) {
 
 func TestAccPortPagePermissionsImport(t *testing.T) {
 	pageIdentifier := utils.GenID()
-	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
-	if err != nil {
-		t.Fatal(err)
-	}
 	var testAccPortPageResourceBasic = createPage(pageIdentifier)
 
 	var testAccBasePagePermissionsConfigUpdate = `
@@ -174,7 +161,7 @@ func TestAccPortPagePermissionsImport(t *testing.T) {
 		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPortPageResourceBasic + testAccBasePagePermissionsConfigUpdate,
+				Config: acctest.ProviderConfig + testAccPortPageResourceBasic + testAccBasePagePermissionsConfigUpdate,
 			},
 			{
 				ResourceName:      "port_page_permissions.microservice_permissions",
@@ -1,21 +1,112 @@
 package page
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+)
 
 type PageModel struct {
-	ID          types.String   `tfsdk:"id"`
-	Identifier  types.String   `tfsdk:"identifier"`
-	Title       types.String   `tfsdk:"title"`
-	Type        types.String   `tfsdk:"type"`
-	Parent      types.String   `tfsdk:"parent"`
-	After       types.String   `tfsdk:"after"`
-	Icon        types.String   `tfsdk:"icon"`
-	Locked      types.Bool     `tfsdk:"locked"`
-	Blueprint   types.String   `tfsdk:"blueprint"`
-	Widgets     []types.String `tfsdk:"widgets"`
-	CreatedAt   types.String   `tfsdk:"created_at"`
-	CreatedBy   types.String   `tfsdk:"created_by"`
-	UpdatedAt   types.String   `tfsdk:"updated_at"`
-	UpdatedBy   types.String   `tfsdk:"updated_by"`
-	Description types.String   `tfsdk:"description"`
+	ID           types.String           `tfsdk:"id"`
+	Identifier   types.String           `tfsdk:"identifier"`
+	Title        types.String           `tfsdk:"title"`
+	Type         types.String           `tfsdk:"type"`
+	Parent       types.String           `tfsdk:"parent"`
+	After        types.String           `tfsdk:"after"`
+	Icon         types.String           `tfsdk:"icon"`
+	Locked       types.Bool             `tfsdk:"locked"`
+	Blueprint    types.String           `tfsdk:"blueprint"`
+	Widgets      []jsontypes.Normalized `tfsdk:"widgets"`
+	TypedWidgets []TypedWidgetModel     `tfsdk:"typed_widgets"`
+	CreatedAt    types.String           `tfsdk:"created_at"`
+	CreatedBy    types.String           `tfsdk:"created_by"`
+	UpdatedAt    types.String           `tfsdk:"updated_at"`
+	UpdatedBy    types.String           `tfsdk:"updated_by"`
+	Description  types.String           `tfsdk:"description"`
+}
+
+type WidgetDatasetModel struct {
+	Combinator types.String           `tfsdk:"combinator"`
+	Rules      []jsontypes.Normalized `tfsdk:"rules"`
+}
+
+type TableEntitiesExplorerWidgetModel struct {
+	ID          types.String        `tfsdk:"id"`
+	Title       types.String        `tfsdk:"title"`
+	Icon        types.String        `tfsdk:"icon"`
+	Description types.String        `tfsdk:"description"`
+	Blueprint   types.String        `tfsdk:"blueprint"`
+	Dataset     *WidgetDatasetModel `tfsdk:"dataset"`
+}
+
+type EntitiesPieChartWidgetModel struct {
+	ID          types.String        `tfsdk:"id"`
+	Title       types.String        `tfsdk:"title"`
+	Icon        types.String        `tfsdk:"icon"`
+	Description types.String        `tfsdk:"description"`
+	Blueprint   types.String        `tfsdk:"blueprint"`
+	Property    types.String        `tfsdk:"property"`
+	Dataset     *WidgetDatasetModel `tfsdk:"dataset"`
+}
+
+type MarkdownWidgetModel struct {
+	ID          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Icon        types.String `tfsdk:"icon"`
+	Description types.String `tfsdk:"description"`
+	Markdown    types.String `tfsdk:"markdown"`
+}
+
+type IframeWidgetModel struct {
+	ID          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Icon        types.String `tfsdk:"icon"`
+	Description types.String `tfsdk:"description"`
+	Url         types.String `tfsdk:"url"`
+	UrlType     types.String `tfsdk:"url_type"`
+}
+
+type NumberChartWidgetModel struct {
+	ID            types.String        `tfsdk:"id"`
+	Title         types.String        `tfsdk:"title"`
+	Icon          types.String        `tfsdk:"icon"`
+	Description   types.String        `tfsdk:"description"`
+	Blueprint     types.String        `tfsdk:"blueprint"`
+	CalculationBy types.String        `tfsdk:"calculation_by"`
+	Func          types.String        `tfsdk:"func"`
+	Property      types.String        `tfsdk:"property"`
+	Unit          types.String        `tfsdk:"unit"`
+	UnitCustom    types.String        `tfsdk:"unit_custom"`
+	Dataset       *WidgetDatasetModel `tfsdk:"dataset"`
+}
+
+type LineChartWidgetModel struct {
+	ID              types.String   `tfsdk:"id"`
+	Title           types.String   `tfsdk:"title"`
+	Icon            types.String   `tfsdk:"icon"`
+	Description     types.String   `tfsdk:"description"`
+	Blueprint       types.String   `tfsdk:"blueprint"`
+	Properties      []types.String `tfsdk:"properties"`
+	TimeInterval    types.String   `tfsdk:"time_interval"`
+	TimeRangePreset types.String   `tfsdk:"time_range_preset"`
+}
+
+type ActionRunsWidgetModel struct {
+	ID          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Icon        types.String `tfsdk:"icon"`
+	Description types.String `tfsdk:"description"`
+	Action      types.String `tfsdk:"action"`
+}
+
+type TypedWidgetModel struct {
+	Size                  types.Int64                       `tfsdk:"size"`
+	Height                types.Int64                       `tfsdk:"height"`
+	TableEntitiesExplorer *TableEntitiesExplorerWidgetModel `tfsdk:"table_entities_explorer"`
+	EntitiesPieChart      *EntitiesPieChartWidgetModel      `tfsdk:"entities_pie_chart"`
+	Markdown              *MarkdownWidgetModel              `tfsdk:"markdown"`
+	Iframe                *IframeWidgetModel                `tfsdk:"iframe"`
+	NumberChart           *NumberChartWidgetModel           `tfsdk:"number_chart"`
+	LineChart             *LineChartWidgetModel             `tfsdk:"line_chart"`
+	ActionRuns            *ActionRunsWidgetModel            `tfsdk:"action_runs"`
+	Raw                   jsontypes.Normalized              `tfsdk:"raw"`
 }
@@ -1,8 +1,8 @@
 package page
 
 import (
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
@@ -19,6 +19,15 @@ func PageToPortBody(pm *PageModel) (*cli.Page, error) {
 		Description: pm.Description.ValueStringPointer(),
 	}
 
+	if pm.TypedWidgets != nil {
+		widgets, err := typedWidgetsToPortBody(pm.Type.ValueString(), pm.TypedWidgets)
+		if err != nil {
+			return nil, err
+		}
+		pb.Widgets = widgets
+		return pb, nil
+	}
+
 	widgets, err := widgetsToPortBody(pm.Widgets)
 	if err != nil {
 		return nil, err
@@ -28,7 +37,7 @@ func PageToPortBody(pm *PageModel) (*cli.Page, error) {
 	return pb, nil
 }
 
-func widgetsToPortBody(widgets []types.String) (*[]map[string]any, error) {
+func widgetsToPortBody(widgets []jsontypes.Normalized) (*[]map[string]any, error) {
 	if widgets == nil {
 		return nil, nil
 	}
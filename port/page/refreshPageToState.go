@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 )
 
 func refreshPageToState(pm *PageModel, b *cli.Page) error {
@@ -17,16 +18,28 @@ func refreshPageToState(pm *PageModel, b *cli.Page) error {
 	pm.Locked = types.BoolPointerValue(b.Locked)
 	pm.Blueprint = types.StringPointerValue(b.Blueprint)
 	pm.Description = types.StringPointerValue(b.Description)
+	pm.CreatedAt = types.StringValue(b.CreatedAt.String())
+	pm.CreatedBy = types.StringValue(b.CreatedBy)
+	pm.UpdatedAt = types.StringValue(b.UpdatedAt.String())
+	pm.UpdatedBy = types.StringValue(b.UpdatedBy)
+
+	if pm.TypedWidgets != nil {
+		var widgets []map[string]any
+		if b.Widgets != nil {
+			widgets = *b.Widgets
+		}
+		return refreshTypedWidgetsToState(pm, widgets)
+	}
 
-	pm.Widgets = make([]types.String, len(*b.Widgets))
 	if b.Widgets != nil {
+		pm.Widgets = make([]jsontypes.Normalized, len(*b.Widgets))
 		// go over each widget and convert it to a string and store it in the widgets array
 		for i, widget := range *b.Widgets {
 			bWidget, err := json.Marshal(widget)
 			if err != nil {
 				return err
 			}
-			pm.Widgets[i] = types.StringValue(string(bWidget))
+			pm.Widgets[i] = jsontypes.NewNormalizedValue(string(bWidget))
 		}
 	}
 	return nil
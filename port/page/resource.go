@@ -2,15 +2,17 @@ package page
 
 import (
 	"context"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/beta"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
 )
 
 var _ resource.Resource = &PageResource{}
 var _ resource.ResourceWithImportState = &PageResource{}
+var _ resource.ResourceWithModifyPlan = &PageResource{}
 
 func NewPageResource() resource.Resource {
 	return &PageResource{}
@@ -32,14 +34,16 @@ func (r *PageResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.portClient = req.ProviderData.(*cli.PortClient)
 }
 
-func (r *PageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("identifier"), req.ID,
-	)...)
+func (r *PageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.portClient == nil {
+		return
+	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("id"), req.ID,
-	)...)
+	resp.Diagnostics.Append(beta.ValidateResource(r.portClient, req.Plan, "Page")...)
+}
+
+func (r *PageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importid.Import(ctx, req, resp, "identifier")
 }
 
 func (r *PageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -2,7 +2,6 @@ package page_test
 
 import (
 	"fmt"
-	"os"
 	"regexp"
 	"testing"
 
@@ -32,10 +31,6 @@ func testAccCreateBlueprintConfig(identifier string) string {
 func TestAccPortPageResourceBasicBetaEnabled(t *testing.T) {
 	blueprintIdentifier := utils.GenID()
 	pageIdentifier := utils.GenID()
-	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
-	if err != nil {
-		t.Fatal(err)
-	}
 	var testAccPortPageResourceBasic = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 
 resource "port_page" "microservice_blueprint_page" {
@@ -80,6 +75,12 @@ resource "port_page" "microservice_blueprint_page" {
 					resource.TestCheckResourceAttr("port_page.microservice_blueprint_page", "widgets.#", "1"),
 				),
 			},
+			{
+				ResourceName:      "port_page.microservice_blueprint_page",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     pageIdentifier,
+			},
 		},
 	})
 }
@@ -87,10 +88,6 @@ resource "port_page" "microservice_blueprint_page" {
 func TestAccPortPageResourceBasicBetaDisabled(t *testing.T) {
 	blueprintIdentifier := utils.GenID()
 	pageIdentifier := utils.GenID()
-	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "false")
-	if err != nil {
-		t.Fatal(err)
-	}
 	var testAccPortPageResourceBasic = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
 
 resource "port_page" "microservice_blueprint_page" {
@@ -126,7 +123,7 @@ resource "port_page" "microservice_blueprint_page" {
 		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config:      acctest.ProviderConfig + testAccPortPageResourceBasic,
+				Config:      acctest.ProviderConfigBetaFeaturesDisabled + testAccPortPageResourceBasic,
 				ExpectError: regexp.MustCompile("Beta features are not enabled"),
 			},
 		},
@@ -135,10 +132,6 @@ resource "port_page" "microservice_blueprint_page" {
 
 func TestAccPortPageResourceCreateDashboardPage(t *testing.T) {
 	pageIdentifier := utils.GenID()
-	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
-	if err != nil {
-		t.Fatal(err)
-	}
 	var testAccPortPageResourceBasic = fmt.Sprintf(`
 
 resource "port_page" "microservice_dashboard_page" {
@@ -200,10 +193,6 @@ resource "port_page" "microservice_dashboard_page" {
 
 func TestAccPortPageResourceCreatePageAfterPage(t *testing.T) {
 	pageIdentifier := utils.GenID()
-	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
-	if err != nil {
-		t.Fatal(err)
-	}
 	var testAccPortPageResourceBasic = fmt.Sprintf(`
 
 resource "port_page" "microservice_dashboard_page" {
@@ -307,3 +296,105 @@ resource "port_page" "microservice_dashboard_page_2" {
 		},
 	})
 }
+
+func TestAccPortPageResourceTypedWidgets(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	pageIdentifier := utils.GenID()
+	var testAccPortPageResourceTypedWidgets = fmt.Sprintf(`
+
+resource "port_page" "microservice_dashboard_page" {
+  identifier            = "%s"
+  title                 = "dashboards"
+  icon                  = "GitHub"
+  type                  = "dashboard"
+  typed_widgets         = [
+    {
+      size = 6
+      markdown = {
+        id       = "microserviceGuide"
+        title    = "Microservices Guide"
+        markdown = "# This is the new Microservice Dashboard"
+      }
+    },
+    {
+      size = 6
+      entities_pie_chart = {
+        id        = "microservicesByText"
+        title     = "Microservices by text"
+        blueprint = port_blueprint.microservice.identifier
+        property  = "property#text"
+        dataset   = {
+          combinator = "and"
+          rules      = [jsonencode({ "operator" : "=", "property" : "$blueprint", "value" : port_blueprint.microservice.identifier })]
+        }
+      }
+    },
+    {
+      iframe = {
+        id       = "overview"
+        title    = "Overview"
+        url      = "https://www.youtube.com/embed/ggXL2ZsPVQM"
+        url_type = "public"
+      }
+    },
+    {
+      raw = jsonencode(
+        {
+          "id" : "rawGuide",
+          "type" : "markdown",
+          "markdown" : "# Raw widget"
+        }
+      )
+    }
+  ]
+}
+`, pageIdentifier)
+
+	var testAccPortPageResourceTypedWidgetsConflict = fmt.Sprintf(`
+
+resource "port_page" "microservice_dashboard_page" {
+  identifier            = "%s"
+  title                 = "dashboards"
+  type                  = "dashboard"
+  widgets               = []
+  typed_widgets         = [
+    {
+      markdown = {
+        id       = "microserviceGuide"
+        markdown = "# This is the new Microservice Dashboard"
+      }
+      iframe = {
+        id  = "overview"
+        url = "https://www.youtube.com/embed/ggXL2ZsPVQM"
+      }
+    }
+  ]
+}
+`, pageIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccCreateBlueprintConfig(blueprintIdentifier) + testAccPortPageResourceTypedWidgetsConflict,
+				ExpectError: regexp.MustCompile(`(?s)Invalid Attribute Combination.*Invalid Attribute Combination`),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccCreateBlueprintConfig(blueprintIdentifier) + testAccPortPageResourceTypedWidgets,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "identifier", pageIdentifier),
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "typed_widgets.#", "4"),
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "typed_widgets.0.size", "6"),
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "typed_widgets.0.height", "400"),
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "typed_widgets.0.markdown.markdown", "# This is the new Microservice Dashboard"),
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "typed_widgets.1.entities_pie_chart.blueprint", blueprintIdentifier),
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "typed_widgets.1.entities_pie_chart.property", "property#text"),
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "typed_widgets.1.entities_pie_chart.dataset.rules.#", "1"),
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "typed_widgets.2.iframe.url_type", "public"),
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "typed_widgets.3.raw", `{"id":"rawGuide","markdown":"# Raw widget","type":"markdown"}`),
+				),
+			},
+		},
+	})
+}
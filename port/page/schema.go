@@ -2,14 +2,19 @@ package page
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"os"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 )
 
 func PageSchema() map[string]schema.Attribute {
@@ -33,7 +38,7 @@ func PageSchema() map[string]schema.Attribute {
 			},
 		},
 		"parent": schema.StringAttribute{
-			Description: "The identifier of the folder in which the page is in, default is the root of the sidebar",
+			Description: "The identifier of the folder in which the page is in, default is the root of the sidebar. Reference a `port_folder` resource to create the folder before the page",
 			Optional:    true,
 		},
 		"after": schema.StringAttribute{
@@ -57,9 +62,19 @@ func PageSchema() map[string]schema.Attribute {
 			Optional:    true,
 		},
 		"widgets": schema.ListAttribute{
-			Description: "The widgets of the page",
+			Description: "The widgets of the page, each widget is a JSON encoded object",
 			Optional:    true,
-			ElementType: types.StringType,
+			ElementType: jsontypes.NormalizedType{},
+			Validators: []validator.List{
+				listvalidator.ConflictsWith(path.MatchRoot("typed_widgets")),
+			},
+		},
+		"typed_widgets": schema.ListNestedAttribute{
+			MarkdownDescription: "The widgets of the page as typed blocks, for pages of type \"dashboard\" and \"home\" the widgets are laid out in rows by their `size`",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: TypedWidgetSchema(),
+			},
 		},
 		"created_at": schema.StringAttribute{
 			MarkdownDescription: "The creation date of the page",
@@ -97,18 +112,211 @@ func (r *PageResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 	}
 }
 
-func (r *PageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var state PageModel
-	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+func widgetDatasetSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The dataset that filters the entities of the widget",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"combinator": schema.StringAttribute{
+				MarkdownDescription: "The combinator of the rules, can be one of \"and\" or \"or\"",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("and", "or"),
+				},
+			},
+			"rules": schema.ListAttribute{
+				MarkdownDescription: "The rules of the dataset, each rule is a JSON encoded object",
+				Required:            true,
+				ElementType:         jsontypes.NormalizedType{},
+			},
+		},
+	}
+}
 
-	if resp.Diagnostics.HasError() {
-		return
+// widgetSchema returns the attributes of a widget type, the attributes every widget has are added to the given ones
+func widgetSchema(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The identifier of the widget",
+		Required:            true,
+	}
+	attributes["title"] = schema.StringAttribute{
+		MarkdownDescription: "The title of the widget",
+		Optional:            true,
+	}
+	attributes["icon"] = schema.StringAttribute{
+		MarkdownDescription: "The icon of the widget",
+		Optional:            true,
+	}
+	attributes["description"] = schema.StringAttribute{
+		MarkdownDescription: "The description of the widget",
+		Optional:            true,
 	}
+	return attributes
+}
 
-	betaFeaturesEnabledEnv := os.Getenv("PORT_BETA_FEATURES_ENABLED")
-	if !(betaFeaturesEnabledEnv == "true") {
-		resp.Diagnostics.AddError("Beta features are not enabled", "Page resource is currently in beta and is subject to change in future versions. Use it by setting the Environment Variable PORT_BETA_FEATURES_ENABLED=true.")
-		return
+func TypedWidgetSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"size": schema.Int64Attribute{
+			MarkdownDescription: "The number of columns out of 12 the widget takes in its row, relevant only for pages of type \"dashboard\" and \"home\"",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(12),
+			Validators: []validator.Int64{
+				int64validator.Between(1, 12),
+			},
+		},
+		"height": schema.Int64Attribute{
+			MarkdownDescription: "The height of the widget row in pixels, a row takes the height of its tallest widget, relevant only for pages of type \"dashboard\" and \"home\"",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(400),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"table_entities_explorer": schema.SingleNestedAttribute{
+			MarkdownDescription: "A table of entities",
+			Optional:            true,
+			Attributes: widgetSchema(map[string]schema.Attribute{
+				"blueprint": schema.StringAttribute{
+					MarkdownDescription: "The blueprint of the entities in the table",
+					Optional:            true,
+				},
+				"dataset": widgetDatasetSchema(),
+			}),
+		},
+		"entities_pie_chart": schema.SingleNestedAttribute{
+			MarkdownDescription: "A pie chart of entities grouped by a property",
+			Optional:            true,
+			Attributes: widgetSchema(map[string]schema.Attribute{
+				"blueprint": schema.StringAttribute{
+					MarkdownDescription: "The blueprint of the entities in the chart",
+					Required:            true,
+				},
+				"property": schema.StringAttribute{
+					MarkdownDescription: "The property the entities are grouped by, in the format `property#<identifier>`",
+					Required:            true,
+				},
+				"dataset": widgetDatasetSchema(),
+			}),
+		},
+		"markdown": schema.SingleNestedAttribute{
+			MarkdownDescription: "A markdown widget",
+			Optional:            true,
+			Attributes: widgetSchema(map[string]schema.Attribute{
+				"markdown": schema.StringAttribute{
+					MarkdownDescription: "The markdown content of the widget",
+					Required:            true,
+				},
+			}),
+			Validators: []validator.Object{
+				objectvalidator.ExactlyOneOf(
+					path.MatchRelative().AtParent().AtName("table_entities_explorer"),
+					path.MatchRelative().AtParent().AtName("entities_pie_chart"),
+					path.MatchRelative().AtParent().AtName("markdown"),
+					path.MatchRelative().AtParent().AtName("iframe"),
+					path.MatchRelative().AtParent().AtName("number_chart"),
+					path.MatchRelative().AtParent().AtName("line_chart"),
+					path.MatchRelative().AtParent().AtName("action_runs"),
+					path.MatchRelative().AtParent().AtName("raw"),
+				),
+			},
+		},
+		"iframe": schema.SingleNestedAttribute{
+			MarkdownDescription: "An iframe widget",
+			Optional:            true,
+			Attributes: widgetSchema(map[string]schema.Attribute{
+				"url": schema.StringAttribute{
+					MarkdownDescription: "The URL of the iframe",
+					Required:            true,
+				},
+				"url_type": schema.StringAttribute{
+					MarkdownDescription: "The type of the URL, can be one of \"public\" or \"protected\"",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("public", "protected"),
+					},
+				},
+			}),
+		},
+		"number_chart": schema.SingleNestedAttribute{
+			MarkdownDescription: "A number chart that counts entities or aggregates a property",
+			Optional:            true,
+			Attributes: widgetSchema(map[string]schema.Attribute{
+				"blueprint": schema.StringAttribute{
+					MarkdownDescription: "The blueprint of the entities in the chart",
+					Required:            true,
+				},
+				"calculation_by": schema.StringAttribute{
+					MarkdownDescription: "Whether the chart counts the entities or aggregates a property, can be one of \"entities\" or \"property\"",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("entities", "property"),
+					},
+				},
+				"func": schema.StringAttribute{
+					MarkdownDescription: "The function of the calculation, can be one of \"count\", \"sum\", \"min\", \"max\", \"average\" or \"median\"",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("count", "sum", "min", "max", "average", "median"),
+					},
+				},
+				"property": schema.StringAttribute{
+					MarkdownDescription: "The property to aggregate, relevant only when `calculation_by` is \"property\"",
+					Optional:            true,
+				},
+				"unit": schema.StringAttribute{
+					MarkdownDescription: "The unit of the number, e.g. \"none\", \"%\", \"$\" or \"custom\"",
+					Optional:            true,
+				},
+				"unit_custom": schema.StringAttribute{
+					MarkdownDescription: "The custom unit of the number, relevant only when `unit` is \"custom\"",
+					Optional:            true,
+				},
+				"dataset": widgetDatasetSchema(),
+			}),
+		},
+		"line_chart": schema.SingleNestedAttribute{
+			MarkdownDescription: "A line chart of properties over time",
+			Optional:            true,
+			Attributes: widgetSchema(map[string]schema.Attribute{
+				"blueprint": schema.StringAttribute{
+					MarkdownDescription: "The blueprint of the entity the chart is showing",
+					Required:            true,
+				},
+				"properties": schema.ListAttribute{
+					MarkdownDescription: "The properties in the chart, in the format `property#<identifier>`",
+					Required:            true,
+					ElementType:         types.StringType,
+				},
+				"time_interval": schema.StringAttribute{
+					MarkdownDescription: "The time interval of the chart, can be one of \"hour\", \"day\", \"week\" or \"month\"",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("hour", "day", "week", "month"),
+					},
+				},
+				"time_range_preset": schema.StringAttribute{
+					MarkdownDescription: "The time range of the chart, e.g. \"lastWeek\", \"lastMonth\" or \"lastYear\"",
+					Required:            true,
+				},
+			}),
+		},
+		"action_runs": schema.SingleNestedAttribute{
+			MarkdownDescription: "A table of the runs of an action",
+			Optional:            true,
+			Attributes: widgetSchema(map[string]schema.Attribute{
+				"action": schema.StringAttribute{
+					MarkdownDescription: "The identifier of the action",
+					Required:            true,
+				},
+			}),
+		},
+		"raw": schema.StringAttribute{
+			MarkdownDescription: "A JSON encoded widget, for widget types that don't have a typed block",
+			Optional:            true,
+			CustomType:          jsontypes.NormalizedType{},
+		},
 	}
 }
 
@@ -122,8 +330,8 @@ A full list of widget types and their identifiers can be found [here](https://do
 
 ~> **WARNING**
 The page resource is currently in beta and is subject to change in future versions.
-Use it by setting the Environment Variable ` + "`PORT_BETA_FEATURES_ENABLED=true`" + `.
-If this Environment Variable isn't specified, you won't be able to use the resource.
+Use it by setting ` + "`beta_features_enabled = true`" + ` in the provider configuration or the Environment Variable ` + "`PORT_BETA_FEATURES_ENABLED=true`" + `.
+If beta features aren't enabled, you won't be able to use the resource.
 
 ## Example Usage
 
@@ -202,6 +410,61 @@ resource "port_page" "microservice_dashboard_page" {
 ` + "```" + `
 
 
+### Dashboard Page with typed widgets
+
+Common widget types can be written as typed blocks instead of JSON, the widgets are laid out in rows by their ` + "`size`" + ` out of 12 columns.
+Use ` + "`raw`" + ` for widget types that don't have a typed block.
+
+` + "```hcl" + `
+
+resource "port_page" "microservice_dashboard_page" {
+  identifier            = "microservice_dashboard_page"
+  title                 = "Microservices"
+  icon                  = "GitHub"
+  type                  = "dashboard"
+  typed_widgets         = [
+    {
+      size   = 6
+      height = 400
+      markdown = {
+        id       = "microserviceGuide"
+        title    = "Microservices Guide"
+        icon     = "BlankPage"
+        markdown = "# This is the new Microservice Dashboard"
+      }
+    },
+    {
+      size = 6
+      entities_pie_chart = {
+        id        = "microservicesByLanguage"
+        title     = "Microservices by language"
+        blueprint = port_blueprint.microservice.identifier
+        property  = "property#language"
+      }
+    },
+    {
+      number_chart = {
+        id             = "microservicesCount"
+        title          = "Number of microservices"
+        blueprint      = port_blueprint.microservice.identifier
+        calculation_by = "entities"
+        func           = "count"
+      }
+    },
+    {
+      raw = jsonencode(
+        {
+          "id" : "deployCard",
+          "type" : "action-card-widget",
+          "actions" : [{ "action" : "deploy" }]
+        }
+      )
+    }
+  ]
+}
+
+` + "```" + `
+
 ### Page with parent
 
 Create a page inside a folder.
@@ -0,0 +1,447 @@
+package page
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+// dashboardWidgetId is the identifier of the dashboard widget the typed widgets of dashboard and home pages are laid
+// out in
+const dashboardWidgetId = "dashboardWidget"
+
+func isDashboardPage(pageType string) bool {
+	return pageType == "dashboard" || pageType == "home"
+}
+
+// setWidgetString sets the key in the widget only when the value is set, so that optional attributes aren't sent as
+// empty values
+func setWidgetString(widget map[string]any, key string, value types.String) {
+	if !value.IsNull() && !value.IsUnknown() {
+		widget[key] = value.ValueString()
+	}
+}
+
+func widgetDatasetToPortBody(dataset *WidgetDatasetModel) (map[string]any, error) {
+	rules := make([]map[string]any, len(dataset.Rules))
+	for i, rule := range dataset.Rules {
+		r, err := utils.TerraformJsonStringToGoObject(rule.ValueStringPointer())
+		if err != nil {
+			return nil, err
+		}
+		rules[i] = *r
+	}
+	return map[string]any{
+		"combinator": dataset.Combinator.ValueString(),
+		"rules":      rules,
+	}, nil
+}
+
+func typedWidgetToPortBody(w TypedWidgetModel) (map[string]any, error) {
+	if !w.Raw.IsNull() {
+		v, err := utils.TerraformJsonStringToGoObject(w.Raw.ValueStringPointer())
+		if err != nil {
+			return nil, err
+		}
+		return *v, nil
+	}
+
+	widget := map[string]any{}
+	var dataset *WidgetDatasetModel
+	setCommon := func(widgetType string, id, title, icon, description types.String) {
+		widget["type"] = widgetType
+		widget["id"] = id.ValueString()
+		setWidgetString(widget, "title", title)
+		setWidgetString(widget, "icon", icon)
+		setWidgetString(widget, "description", description)
+	}
+
+	switch {
+	case w.TableEntitiesExplorer != nil:
+		t := w.TableEntitiesExplorer
+		setCommon("table-entities-explorer", t.ID, t.Title, t.Icon, t.Description)
+		setWidgetString(widget, "blueprint", t.Blueprint)
+		dataset = t.Dataset
+	case w.EntitiesPieChart != nil:
+		p := w.EntitiesPieChart
+		setCommon("entities-pie-chart", p.ID, p.Title, p.Icon, p.Description)
+		setWidgetString(widget, "blueprint", p.Blueprint)
+		setWidgetString(widget, "property", p.Property)
+		dataset = p.Dataset
+	case w.Markdown != nil:
+		m := w.Markdown
+		setCommon("markdown", m.ID, m.Title, m.Icon, m.Description)
+		setWidgetString(widget, "markdown", m.Markdown)
+	case w.Iframe != nil:
+		i := w.Iframe
+		setCommon("iframe-widget", i.ID, i.Title, i.Icon, i.Description)
+		setWidgetString(widget, "url", i.Url)
+		setWidgetString(widget, "urlType", i.UrlType)
+	case w.NumberChart != nil:
+		n := w.NumberChart
+		setCommon("entities-number-chart", n.ID, n.Title, n.Icon, n.Description)
+		setWidgetString(widget, "blueprint", n.Blueprint)
+		setWidgetString(widget, "calculationBy", n.CalculationBy)
+		setWidgetString(widget, "func", n.Func)
+		setWidgetString(widget, "property", n.Property)
+		setWidgetString(widget, "unit", n.Unit)
+		setWidgetString(widget, "unitCustom", n.UnitCustom)
+		dataset = n.Dataset
+	case w.LineChart != nil:
+		l := w.LineChart
+		setCommon("line-chart", l.ID, l.Title, l.Icon, l.Description)
+		setWidgetString(widget, "blueprint", l.Blueprint)
+		properties := make([]string, len(l.Properties))
+		for i, property := range l.Properties {
+			properties[i] = property.ValueString()
+		}
+		widget["properties"] = properties
+		setWidgetString(widget, "timeInterval", l.TimeInterval)
+		widget["timeRange"] = map[string]any{"preset": l.TimeRangePreset.ValueString()}
+	case w.ActionRuns != nil:
+		a := w.ActionRuns
+		setCommon("action-runs-table-widget", a.ID, a.Title, a.Icon, a.Description)
+		setWidgetString(widget, "action", a.Action)
+	default:
+		return nil, fmt.Errorf("widget must have exactly one widget type")
+	}
+
+	if dataset != nil {
+		d, err := widgetDatasetToPortBody(dataset)
+		if err != nil {
+			return nil, err
+		}
+		widget["dataset"] = d
+	}
+	return widget, nil
+}
+
+// dashboardLayout places the widgets in rows from left to right, a widget that doesn't fit in the 12 columns left in
+// the row starts a new row
+func dashboardLayout(widgets []map[string]any, typedWidgets []TypedWidgetModel) []map[string]any {
+	var layout []map[string]any
+	var columns []map[string]any
+	rowSize := int64(0)
+	rowHeight := int64(0)
+	closeRow := func() {
+		if len(columns) > 0 {
+			layout = append(layout, map[string]any{"height": rowHeight, "columns": columns})
+		}
+		columns = nil
+		rowSize = 0
+		rowHeight = 0
+	}
+
+	for i, widget := range widgets {
+		size := typedWidgets[i].Size.ValueInt64()
+		if rowSize+size > 12 {
+			closeRow()
+		}
+		columns = append(columns, map[string]any{"id": widget["id"], "size": size})
+		rowSize += size
+		if height := typedWidgets[i].Height.ValueInt64(); height > rowHeight {
+			rowHeight = height
+		}
+	}
+	closeRow()
+	return layout
+}
+
+func typedWidgetsToPortBody(pageType string, typedWidgets []TypedWidgetModel) (*[]map[string]any, error) {
+	widgets := make([]map[string]any, len(typedWidgets))
+	for i, w := range typedWidgets {
+		widget, err := typedWidgetToPortBody(w)
+		if err != nil {
+			return nil, err
+		}
+		widgets[i] = widget
+	}
+
+	if !isDashboardPage(pageType) {
+		return &widgets, nil
+	}
+
+	return &[]map[string]any{
+		{
+			"id":      dashboardWidgetId,
+			"type":    "dashboard-widget",
+			"widgets": widgets,
+			"layout":  dashboardLayout(widgets, typedWidgets),
+		},
+	}, nil
+}
+
+// widgetString returns the value of the key in the widget, an empty value is kept null when it was null before so
+// that defaults filled in by Port don't show as changes
+func widgetString(widget map[string]any, key string, prior types.String) types.String {
+	value, ok := widget[key].(string)
+	if !ok || (value == "" && prior.IsNull()) {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func refreshWidgetDataset(widget map[string]any, prior *WidgetDatasetModel) (*WidgetDatasetModel, error) {
+	dataset, ok := widget["dataset"].(map[string]any)
+	if !ok || (prior == nil && len(dataset) == 0) {
+		return nil, nil
+	}
+	d := &WidgetDatasetModel{Rules: []jsontypes.Normalized{}}
+	if prior == nil {
+		prior = &WidgetDatasetModel{}
+	}
+	d.Combinator = widgetString(dataset, "combinator", prior.Combinator)
+	rules, _ := dataset["rules"].([]any)
+	for _, rule := range rules {
+		r, err := json.Marshal(rule)
+		if err != nil {
+			return nil, err
+		}
+		d.Rules = append(d.Rules, jsontypes.NewNormalizedValue(string(r)))
+	}
+	return d, nil
+}
+
+// refreshTypedWidget converts a Port widget to its typed block, widgets without a typed block and widgets that were
+// configured as raw JSON are kept as raw JSON
+func refreshTypedWidget(widget map[string]any, prior *TypedWidgetModel) (TypedWidgetModel, error) {
+	w := TypedWidgetModel{Size: types.Int64Value(12), Height: types.Int64Value(400), Raw: jsontypes.NewNormalizedNull()}
+	if prior == nil {
+		prior = &TypedWidgetModel{}
+	} else {
+		w.Size = prior.Size
+		w.Height = prior.Height
+	}
+
+	var err error
+	widgetType, _ := widget["type"].(string)
+	switch {
+	case !prior.Raw.IsNull():
+	case widgetType == "table-entities-explorer":
+		p := prior.TableEntitiesExplorer
+		if p == nil {
+			p = &TableEntitiesExplorerWidgetModel{}
+		}
+		t := &TableEntitiesExplorerWidgetModel{
+			ID:          widgetString(widget, "id", p.ID),
+			Title:       widgetString(widget, "title", p.Title),
+			Icon:        widgetString(widget, "icon", p.Icon),
+			Description: widgetString(widget, "description", p.Description),
+			Blueprint:   widgetString(widget, "blueprint", p.Blueprint),
+		}
+		t.Dataset, err = refreshWidgetDataset(widget, p.Dataset)
+		w.TableEntitiesExplorer = t
+		return w, err
+	case widgetType == "entities-pie-chart":
+		p := prior.EntitiesPieChart
+		if p == nil {
+			p = &EntitiesPieChartWidgetModel{}
+		}
+		c := &EntitiesPieChartWidgetModel{
+			ID:          widgetString(widget, "id", p.ID),
+			Title:       widgetString(widget, "title", p.Title),
+			Icon:        widgetString(widget, "icon", p.Icon),
+			Description: widgetString(widget, "description", p.Description),
+			Blueprint:   widgetString(widget, "blueprint", p.Blueprint),
+			Property:    widgetString(widget, "property", p.Property),
+		}
+		c.Dataset, err = refreshWidgetDataset(widget, p.Dataset)
+		w.EntitiesPieChart = c
+		return w, err
+	case widgetType == "markdown":
+		p := prior.Markdown
+		if p == nil {
+			p = &MarkdownWidgetModel{}
+		}
+		w.Markdown = &MarkdownWidgetModel{
+			ID:          widgetString(widget, "id", p.ID),
+			Title:       widgetString(widget, "title", p.Title),
+			Icon:        widgetString(widget, "icon", p.Icon),
+			Description: widgetString(widget, "description", p.Description),
+			Markdown:    widgetString(widget, "markdown", p.Markdown),
+		}
+		return w, nil
+	case widgetType == "iframe-widget":
+		p := prior.Iframe
+		if p == nil {
+			p = &IframeWidgetModel{}
+		}
+		w.Iframe = &IframeWidgetModel{
+			ID:          widgetString(widget, "id", p.ID),
+			Title:       widgetString(widget, "title", p.Title),
+			Icon:        widgetString(widget, "icon", p.Icon),
+			Description: widgetString(widget, "description", p.Description),
+			Url:         widgetString(widget, "url", p.Url),
+			UrlType:     widgetString(widget, "urlType", p.UrlType),
+		}
+		return w, nil
+	case widgetType == "entities-number-chart":
+		p := prior.NumberChart
+		if p == nil {
+			p = &NumberChartWidgetModel{}
+		}
+		n := &NumberChartWidgetModel{
+			ID:            widgetString(widget, "id", p.ID),
+			Title:         widgetString(widget, "title", p.Title),
+			Icon:          widgetString(widget, "icon", p.Icon),
+			Description:   widgetString(widget, "description", p.Description),
+			Blueprint:     widgetString(widget, "blueprint", p.Blueprint),
+			CalculationBy: widgetString(widget, "calculationBy", p.CalculationBy),
+			Func:          widgetString(widget, "func", p.Func),
+			Property:      widgetString(widget, "property", p.Property),
+			Unit:          widgetString(widget, "unit", p.Unit),
+			UnitCustom:    widgetString(widget, "unitCustom", p.UnitCustom),
+		}
+		n.Dataset, err = refreshWidgetDataset(widget, p.Dataset)
+		w.NumberChart = n
+		return w, err
+	case widgetType == "line-chart":
+		p := prior.LineChart
+		if p == nil {
+			p = &LineChartWidgetModel{}
+		}
+		l := &LineChartWidgetModel{
+			ID:           widgetString(widget, "id", p.ID),
+			Title:        widgetString(widget, "title", p.Title),
+			Icon:         widgetString(widget, "icon", p.Icon),
+			Description:  widgetString(widget, "description", p.Description),
+			Blueprint:    widgetString(widget, "blueprint", p.Blueprint),
+			Properties:   []types.String{},
+			TimeInterval: widgetString(widget, "timeInterval", p.TimeInterval),
+		}
+		properties, _ := widget["properties"].([]any)
+		for _, property := range properties {
+			if s, ok := property.(string); ok {
+				l.Properties = append(l.Properties, types.StringValue(s))
+			}
+		}
+		timeRange, _ := widget["timeRange"].(map[string]any)
+		l.TimeRangePreset = widgetString(timeRange, "preset", p.TimeRangePreset)
+		w.LineChart = l
+		return w, nil
+	case widgetType == "action-runs-table-widget":
+		p := prior.ActionRuns
+		if p == nil {
+			p = &ActionRunsWidgetModel{}
+		}
+		w.ActionRuns = &ActionRunsWidgetModel{
+			ID:          widgetString(widget, "id", p.ID),
+			Title:       widgetString(widget, "title", p.Title),
+			Icon:        widgetString(widget, "icon", p.Icon),
+			Description: widgetString(widget, "description", p.Description),
+			Action:      widgetString(widget, "action", p.Action),
+		}
+		return w, nil
+	}
+
+	raw, err := json.Marshal(widget)
+	if err != nil {
+		return w, err
+	}
+	w.Raw = jsontypes.NewNormalizedValue(string(raw))
+	return w, nil
+}
+
+func refreshTypedWidgetsToState(pm *PageModel, pageWidgets []map[string]any) error {
+	priorById := make(map[string]*TypedWidgetModel)
+	for i := range pm.TypedWidgets {
+		if id := typedWidgetId(pm.TypedWidgets[i]); id != "" {
+			priorById[id] = &pm.TypedWidgets[i]
+		}
+	}
+
+	widgets := pageWidgets
+	var layout []any
+	if isDashboardPage(pm.Type.ValueString()) {
+		for _, w := range pageWidgets {
+			if w["type"] == "dashboard-widget" {
+				widgets = nil
+				dashboardWidgets, _ := w["widgets"].([]any)
+				for _, dashboardWidget := range dashboardWidgets {
+					if widget, ok := dashboardWidget.(map[string]any); ok {
+						widgets = append(widgets, widget)
+					}
+				}
+				layout, _ = w["layout"].([]any)
+				break
+			}
+		}
+	}
+
+	typedWidgets := make([]TypedWidgetModel, len(widgets))
+	for i, widget := range widgets {
+		id, _ := widget["id"].(string)
+		w, err := refreshTypedWidget(widget, priorById[id])
+		if err != nil {
+			return err
+		}
+		typedWidgets[i] = w
+	}
+	refreshTypedWidgetsLayout(typedWidgets, layout)
+	pm.TypedWidgets = typedWidgets
+	return nil
+}
+
+// refreshTypedWidgetsLayout reads the size and height of each widget from the dashboard layout. A row is as tall as
+// its tallest widget, so the heights of a row's widgets only change when they don't add up to the height of the row
+func refreshTypedWidgetsLayout(typedWidgets []TypedWidgetModel, layout []any) {
+	indexById := make(map[string]int)
+	for i, w := range typedWidgets {
+		indexById[typedWidgetId(w)] = i
+	}
+	for _, r := range layout {
+		row, _ := r.(map[string]any)
+		columns, _ := row["columns"].([]any)
+		var rowWidgets []int
+		rowHeight := int64(0)
+		for _, c := range columns {
+			column, _ := c.(map[string]any)
+			id, _ := column["id"].(string)
+			i, ok := indexById[id]
+			if !ok {
+				continue
+			}
+			if size, ok := column["size"].(float64); ok {
+				typedWidgets[i].Size = types.Int64Value(int64(size))
+			}
+			rowWidgets = append(rowWidgets, i)
+			if height := typedWidgets[i].Height.ValueInt64(); height > rowHeight {
+				rowHeight = height
+			}
+		}
+		if height, ok := row["height"].(float64); ok && int64(height) != rowHeight {
+			for _, i := range rowWidgets {
+				typedWidgets[i].Height = types.Int64Value(int64(height))
+			}
+		}
+	}
+}
+
+func typedWidgetId(w TypedWidgetModel) string {
+	switch {
+	case w.TableEntitiesExplorer != nil:
+		return w.TableEntitiesExplorer.ID.ValueString()
+	case w.EntitiesPieChart != nil:
+		return w.EntitiesPieChart.ID.ValueString()
+	case w.Markdown != nil:
+		return w.Markdown.ID.ValueString()
+	case w.Iframe != nil:
+		return w.Iframe.ID.ValueString()
+	case w.NumberChart != nil:
+		return w.NumberChart.ID.ValueString()
+	case w.LineChart != nil:
+		return w.LineChart.ID.ValueString()
+	case w.ActionRuns != nil:
+		return w.ActionRuns.ID.ValueString()
+	case !w.Raw.IsNull():
+		var raw map[string]any
+		if err := json.Unmarshal([]byte(w.Raw.ValueString()), &raw); err == nil {
+			id, _ := raw["id"].(string)
+			return id
+		}
+	}
+	return ""
+}
@@ -0,0 +1,16 @@
+package scorecard_rule
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/scorecard"
+)
+
+type ScorecardRuleModel struct {
+	ID                  types.String     `tfsdk:"id"`
+	BlueprintIdentifier types.String     `tfsdk:"blueprint_identifier"`
+	ScorecardIdentifier types.String     `tfsdk:"scorecard_identifier"`
+	RuleIdentifier      types.String     `tfsdk:"rule_identifier"`
+	Title               types.String     `tfsdk:"title"`
+	Level               types.String     `tfsdk:"level"`
+	Query               *scorecard.Query `tfsdk:"query"`
+}
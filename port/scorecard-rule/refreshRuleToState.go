@@ -0,0 +1,21 @@
+package scorecard_rule
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/scorecard"
+)
+
+func refreshRuleState(state *ScorecardRuleModel, rule cli.Rule, blueprintIdentifier string, scorecardIdentifier string) {
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", blueprintIdentifier, scorecardIdentifier, rule.Identifier))
+	state.BlueprintIdentifier = types.StringValue(blueprintIdentifier)
+	state.ScorecardIdentifier = types.StringValue(scorecardIdentifier)
+
+	stateRule := scorecard.RuleToState(rule)
+	state.RuleIdentifier = stateRule.Identifier
+	state.Title = stateRule.Title
+	state.Level = stateRule.Level
+	state.Query = stateRule.Query
+}
@@ -0,0 +1,231 @@
+package scorecard_rule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"github.com/samber/lo"
+)
+
+var _ resource.Resource = &ScorecardRuleResource{}
+var _ resource.ResourceWithImportState = &ScorecardRuleResource{}
+var _ resource.ResourceWithModifyPlan = &ScorecardRuleResource{}
+
+func NewScorecardRuleResource() resource.Resource {
+	return &ScorecardRuleResource{}
+}
+
+type ScorecardRuleResource struct {
+	portClient *cli.PortClient
+}
+
+func (r *ScorecardRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scorecard_rule"
+}
+
+func (r *ScorecardRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+// ModifyPlan validates that the level of the rule exists in its scorecard, as the levels of the rules of a
+// port_scorecard are validated, the rules of scorecards that are created or changed in the same apply are left to the API
+func (r *ScorecardRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.portClient == nil || !utils.HasPlannedChanges(req) || req.Plan.Raw.IsNull() {
+		return
+	}
+	var blueprintIdentifier, scorecardIdentifier, level types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("blueprint_identifier"), &blueprintIdentifier)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("scorecard_identifier"), &scorecardIdentifier)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("level"), &level)...)
+	if resp.Diagnostics.HasError() || blueprintIdentifier.IsUnknown() || scorecardIdentifier.IsUnknown() || level.IsUnknown() {
+		return
+	}
+	if r.portClient.PendingScorecards.Contains(blueprintIdentifier.ValueString() + importid.Separator + scorecardIdentifier.ValueString()) {
+		return
+	}
+
+	s, statusCode, err := r.portClient.ReadScorecard(ctx, blueprintIdentifier.ValueString(), scorecardIdentifier.ValueString())
+	if err != nil {
+		// The scorecard is created in the same apply
+		if statusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError("failed to read scorecard", err.Error())
+		return
+	}
+
+	if err = validateRuleLevel(s, level.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("level"), "invalid scorecard rule level", err.Error())
+	}
+}
+
+func (r *ScorecardRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importid.Import(ctx, req, resp, "blueprint_identifier", "scorecard_identifier", "rule_identifier")
+}
+
+func (r *ScorecardRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ScorecardRuleModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blueprintIdentifier := state.BlueprintIdentifier.ValueString()
+	scorecardIdentifier := state.ScorecardIdentifier.ValueString()
+	s, statusCode, err := r.portClient.ReadScorecard(ctx, blueprintIdentifier, scorecardIdentifier)
+	if err != nil {
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read scorecard", err.Error())
+		return
+	}
+
+	rule, ok := findRule(s, state.RuleIdentifier.ValueString())
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	refreshRuleState(state, rule, blueprintIdentifier, scorecardIdentifier)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ScorecardRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state *ScorecardRuleModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.writeRule(ctx, state, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ScorecardRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state *ScorecardRuleModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.writeRule(ctx, state, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ScorecardRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *ScorecardRuleModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blueprintIdentifier := state.BlueprintIdentifier.ValueString()
+	scorecardIdentifier := state.ScorecardIdentifier.ValueString()
+	ruleIdentifier := state.RuleIdentifier.ValueString()
+
+	unlock := utils.ScorecardMutex.Lock(fmt.Sprintf("%s:%s", blueprintIdentifier, scorecardIdentifier))
+	defer unlock()
+
+	s, statusCode, err := r.portClient.ReadScorecard(ctx, blueprintIdentifier, scorecardIdentifier)
+	if err != nil {
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read scorecard", err.Error())
+		return
+	}
+
+	if _, ok := findRule(s, ruleIdentifier); !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	body := scorecardToPortBody(s)
+	body.Rules = lo.Filter(body.Rules, func(rule cli.Rule, _ int) bool {
+		return rule.Identifier != ruleIdentifier
+	})
+
+	_, err = r.portClient.UpdateScorecard(ctx, blueprintIdentifier, scorecardIdentifier, body)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete scorecard rule", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// writeRule adds the rule to the scorecard, or replaces the existing rule with the same identifier
+func (r *ScorecardRuleResource) writeRule(ctx context.Context, state *ScorecardRuleModel, create bool, diags *diag.Diagnostics) {
+	rule, err := ruleToPortBody(state)
+	if err != nil {
+		diags.AddError("failed to convert scorecard rule to port valid request", err.Error())
+		return
+	}
+
+	blueprintIdentifier := state.BlueprintIdentifier.ValueString()
+	scorecardIdentifier := state.ScorecardIdentifier.ValueString()
+
+	unlock := utils.ScorecardMutex.Lock(fmt.Sprintf("%s:%s", blueprintIdentifier, scorecardIdentifier))
+	defer unlock()
+
+	s, statusCode, err := r.portClient.ReadScorecard(ctx, blueprintIdentifier, scorecardIdentifier)
+	if err != nil {
+		if statusCode == 404 {
+			diags.AddError("Scorecard doesn't exists, it is required to manage a scorecard rule", err.Error())
+			return
+		}
+		diags.AddError("failed to read scorecard", err.Error())
+		return
+	}
+
+	if _, ok := findRule(s, rule.Identifier); ok && create {
+		diags.AddError("scorecard rule already exists", fmt.Sprintf("rule %s already exists in scorecard %s", rule.Identifier, scorecardIdentifier))
+		return
+	}
+
+	body := scorecardToPortBody(s)
+	setRule(body, *rule)
+
+	sp, err := r.portClient.UpdateScorecard(ctx, blueprintIdentifier, scorecardIdentifier, body)
+	if err != nil {
+		diags.AddError("failed to update scorecard rule", err.Error())
+		return
+	}
+
+	updatedRule, ok := findRule(sp, rule.Identifier)
+	if !ok {
+		updatedRule = *rule
+	}
+	refreshRuleState(state, updatedRule, blueprintIdentifier, scorecardIdentifier)
+}
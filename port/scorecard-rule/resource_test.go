@@ -0,0 +1,231 @@
+package scorecard_rule_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func testAccCreateScorecardConfig(blueprintIdentifier string, scorecardIdentifier string) string {
+	return fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF test microservice"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			string_props = {
+				"author" = {
+					title = "Author"
+				}
+			}
+		}
+	}
+
+	resource "port_scorecard" "readiness" {
+		identifier = "%s"
+		title      = "Readiness"
+		blueprint  = port_blueprint.microservice.identifier
+		ignore_external_rules = true
+		rules = [{
+		  identifier = "hasTeam"
+		  title      = "Has Team"
+		  level      = "Gold"
+		  query = {
+			combinator = "and"
+			conditions = [jsonencode({
+			  property = "$team"
+			  operator = "isNotEmpty"
+			})]
+		  }
+		}]
+	}
+	`, blueprintIdentifier, scorecardIdentifier)
+}
+
+func TestAccPortScorecardRuleBasic(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	scorecardIdentifier := utils.GenID()
+	var testAccConfigCreate = testAccCreateScorecardConfig(blueprintIdentifier, scorecardIdentifier) + `
+	resource "port_scorecard_rule" "has_author" {
+		blueprint_identifier = port_scorecard.readiness.blueprint
+		scorecard_identifier = port_scorecard.readiness.identifier
+		rule_identifier      = "hasAuthor"
+		title                = "Has Author"
+		level                = "Silver"
+		query = {
+		  combinator = "and"
+		  conditions = [jsonencode({
+			property = "author"
+			operator = "isNotEmpty"
+		  })]
+		}
+	}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_scorecard_rule.has_author", "id", fmt.Sprintf("%s:%s:hasAuthor", blueprintIdentifier, scorecardIdentifier)),
+					resource.TestCheckResourceAttr("port_scorecard_rule.has_author", "title", "Has Author"),
+					resource.TestCheckResourceAttr("port_scorecard_rule.has_author", "level", "Silver"),
+					resource.TestCheckResourceAttr("port_scorecard_rule.has_author", "query.combinator", "and"),
+					resource.TestCheckResourceAttr("port_scorecard_rule.has_author", "query.conditions.#", "1"),
+					resource.TestCheckResourceAttr("port_scorecard_rule.has_author", "query.conditions.0", "{\"operator\":\"isNotEmpty\",\"property\":\"author\"}"),
+					resource.TestCheckResourceAttr("port_scorecard.readiness", "rules.#", "1"),
+					resource.TestCheckResourceAttr("port_scorecard.readiness", "rules.0.identifier", "hasTeam"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortScorecardRuleUpdate(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	scorecardIdentifier := utils.GenID()
+	var testAccConfigCreate = testAccCreateScorecardConfig(blueprintIdentifier, scorecardIdentifier) + `
+	resource "port_scorecard_rule" "has_author" {
+		blueprint_identifier = port_scorecard.readiness.blueprint
+		scorecard_identifier = port_scorecard.readiness.identifier
+		rule_identifier      = "hasAuthor"
+		title                = "Has Author"
+		level                = "Silver"
+		query = {
+		  combinator = "and"
+		  conditions = [jsonencode({
+			property = "author"
+			operator = "isNotEmpty"
+		  })]
+		}
+	}
+`
+
+	var testAccConfigUpdate = testAccCreateScorecardConfig(blueprintIdentifier, scorecardIdentifier) + `
+	resource "port_scorecard_rule" "has_author" {
+		blueprint_identifier = port_scorecard.readiness.blueprint
+		scorecard_identifier = port_scorecard.readiness.identifier
+		rule_identifier      = "hasAuthor"
+		title                = "Has Author Or Team"
+		level                = "Bronze"
+		query = {
+		  combinator = "or"
+		  conditions = [jsonencode({
+			property = "author"
+			operator = "isNotEmpty"
+		  }), jsonencode({
+			property = "$team"
+			operator = "isNotEmpty"
+		  })]
+		}
+	}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_scorecard_rule.has_author", "level", "Silver"),
+					resource.TestCheckResourceAttr("port_scorecard_rule.has_author", "query.conditions.#", "1"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_scorecard_rule.has_author", "title", "Has Author Or Team"),
+					resource.TestCheckResourceAttr("port_scorecard_rule.has_author", "level", "Bronze"),
+					resource.TestCheckResourceAttr("port_scorecard_rule.has_author", "query.combinator", "or"),
+					resource.TestCheckResourceAttr("port_scorecard_rule.has_author", "query.conditions.#", "2"),
+					resource.TestCheckResourceAttr("port_scorecard.readiness", "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortScorecardRuleInvalidLevel(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	scorecardIdentifier := utils.GenID()
+	var testAccConfigCreate = testAccCreateScorecardConfig(blueprintIdentifier, scorecardIdentifier) + `
+	resource "port_scorecard_rule" "has_author" {
+		blueprint_identifier = port_scorecard.readiness.blueprint
+		scorecard_identifier = port_scorecard.readiness.identifier
+		rule_identifier      = "hasAuthor"
+		title                = "Has Author"
+		level                = "Platinum"
+		query = {
+		  combinator = "and"
+		  conditions = [jsonencode({
+			property = "author"
+			operator = "isNotEmpty"
+		  })]
+		}
+	}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccCreateScorecardConfig(blueprintIdentifier, scorecardIdentifier),
+			},
+			{
+				// the level is validated by the plan against the levels of the existing scorecard
+				Config:             acctest.ProviderConfig + testAccConfigCreate,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ExpectError:        regexp.MustCompile("level Platinum doesn't exist in scorecard"),
+			},
+		},
+	})
+}
+
+func TestAccPortScorecardRuleImport(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	scorecardIdentifier := utils.GenID()
+	var testAccConfigCreate = testAccCreateScorecardConfig(blueprintIdentifier, scorecardIdentifier) + `
+	resource "port_scorecard_rule" "has_author" {
+		blueprint_identifier = port_scorecard.readiness.blueprint
+		scorecard_identifier = port_scorecard.readiness.identifier
+		rule_identifier      = "hasAuthor"
+		title                = "Has Author"
+		level                = "Silver"
+		query = {
+		  combinator = "and"
+		  conditions = [jsonencode({
+			property = "author"
+			operator = "isNotEmpty"
+		  })]
+		}
+	}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_scorecard_rule.has_author", "title", "Has Author"),
+				),
+			},
+			{
+				ResourceName:      "port_scorecard_rule.has_author",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s:%s:hasAuthor", blueprintIdentifier, scorecardIdentifier),
+			},
+		},
+	})
+}
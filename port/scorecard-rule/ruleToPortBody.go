@@ -0,0 +1,56 @@
+package scorecard_rule
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/scorecard"
+	"github.com/samber/lo"
+)
+
+func ruleToPortBody(state *ScorecardRuleModel) (*cli.Rule, error) {
+	return scorecard.RuleToPortBody(scorecard.Rule{
+		Identifier: state.RuleIdentifier,
+		Title:      state.Title,
+		Level:      state.Level,
+		Query:      state.Query,
+	})
+}
+
+// scorecardToPortBody keeps only the fields of the scorecard that can be sent back to Port on update
+func scorecardToPortBody(s *cli.Scorecard) *cli.Scorecard {
+	return &cli.Scorecard{
+		Identifier: s.Identifier,
+		Title:      s.Title,
+		Levels:     s.Levels,
+		Rules:      s.Rules,
+	}
+}
+
+func validateRuleLevel(s *cli.Scorecard, level string) error {
+	levels := lo.Map(s.Levels, func(l cli.Level, _ int) string {
+		return l.Title
+	})
+	if !lo.Contains(levels, level) {
+		return fmt.Errorf("level %s doesn't exist in scorecard %s, available levels are: %s", level, s.Identifier, strings.Join(levels, ", "))
+	}
+	return nil
+}
+
+// setRule replaces the rule with the same identifier or appends it if it doesn't exist, keeping the order of the rules
+func setRule(s *cli.Scorecard, rule cli.Rule) {
+	for i := range s.Rules {
+		if s.Rules[i].Identifier == rule.Identifier {
+			s.Rules[i] = rule
+			return
+		}
+	}
+	s.Rules = append(s.Rules, rule)
+}
+
+func findRule(s *cli.Scorecard, identifier string) (cli.Rule, bool) {
+	return lo.Find(s.Rules, func(rule cli.Rule) bool {
+		return rule.Identifier == identifier
+	})
+}
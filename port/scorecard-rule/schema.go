@@ -0,0 +1,112 @@
+package scorecard_rule
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/scorecard"
+)
+
+func ScorecardRuleSchema() map[string]schema.Attribute {
+	ruleSchema := scorecard.RuleSchema()
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"blueprint_identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the blueprint of the scorecard",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"scorecard_identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the scorecard the rule will be added to",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"rule_identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the rule",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"title": ruleSchema["title"],
+		"level": schema.StringAttribute{
+			MarkdownDescription: "The level of the rule, must be one of the levels of the scorecard",
+			Required:            true,
+		},
+		"query": ruleSchema["query"],
+	}
+}
+
+func (r *ScorecardRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ScorecardRuleResourceMarkdownDescription,
+		Attributes:          ScorecardRuleSchema(),
+	}
+}
+
+var ScorecardRuleResourceMarkdownDescription = `
+
+# Scorecard Rule
+
+This resource allows you to manage a single rule of an existing scorecard, so different teams can contribute their own rules to a shared scorecard.
+
+The level of the rule is validated against the levels of the scorecard when the rule is planned, unless the scorecard is created or changed in the same apply.
+
+The scorecard the rule is added to should set ` + "`ignore_external_rules = true`" + `, otherwise the ` + "`port_scorecard`" + ` resource will remove the rule on its next apply.
+
+## Example Usage
+
+` + "```hcl" + `
+
+resource "port_scorecard" "production_readiness" {
+  identifier            = "productionReadiness"
+  title                 = "Production Readiness"
+  blueprint             = "microservice"
+  ignore_external_rules = true
+  rules = [
+    {
+      identifier = "hasTeam"
+      title      = "Has Team"
+      level      = "Bronze"
+      query = {
+        combinator = "and"
+        conditions = [
+          jsonencode({
+            property = "$team"
+            operator = "isNotEmpty"
+          })
+        ]
+      }
+    }
+  ]
+}
+
+resource "port_scorecard_rule" "has_owner" {
+  blueprint_identifier = port_scorecard.production_readiness.blueprint
+  scorecard_identifier = port_scorecard.production_readiness.identifier
+  rule_identifier      = "hasOwner"
+  title                = "Has Owner"
+  level                = "Gold"
+  query = {
+    combinator = "and"
+    conditions = [
+      jsonencode({
+        property = "$team"
+        operator = "isNotEmpty"
+      })
+    ]
+  }
+}
+
+` + "```" + `
+
+`
@@ -0,0 +1,134 @@
+package scorecard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/samber/lo"
+)
+
+var _ validator.String = conditionValidator{}
+
+// conditionValueKinds maps the operators supported in scorecard rule conditions to the kind of value they expect
+var conditionValueKinds = map[string]string{
+	"=":                 "any",
+	"!=":                "any",
+	">":                 "comparable",
+	">=":                "comparable",
+	"<":                 "comparable",
+	"<=":                "comparable",
+	"contains":          "string",
+	"doesNotContains":   "string",
+	"beginsWith":        "string",
+	"doesNotBeginsWith": "string",
+	"endsWith":          "string",
+	"doesNotEndsWith":   "string",
+	"containsAny":       "array",
+	"in":                "array",
+	"notIn":             "array",
+	"between":           "dateRange",
+	"notBetween":        "dateRange",
+	"isEmpty":           "none",
+	"isNotEmpty":        "none",
+}
+
+// conditionValidator validates that a rule condition is a JSON encoded object with a supported operator, either a
+// property or a relation, and a value matching the operator
+type conditionValidator struct{}
+
+func (v conditionValidator) Description(ctx context.Context) string {
+	return "the condition must be a JSON encoded object with a supported operator"
+}
+
+func (v conditionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v conditionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidateCondition(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid scorecard rule condition", err.Error())
+	}
+}
+
+// ValidateCondition checks a JSON encoded scorecard rule condition against the supported operators and their values
+func ValidateCondition(condition string) error {
+	var c map[string]any
+	if err := json.Unmarshal([]byte(condition), &c); err != nil {
+		return fmt.Errorf("condition must be a JSON encoded object: %s", err.Error())
+	}
+
+	for key := range c {
+		if !lo.Contains([]string{"property", "relation", "operator", "value"}, key) {
+			return fmt.Errorf("unsupported key %q, conditions support the keys property, relation, operator and value", key)
+		}
+	}
+
+	_, hasProperty := c["property"]
+	_, hasRelation := c["relation"]
+	if hasProperty == hasRelation {
+		return fmt.Errorf("condition must have exactly one of property or relation")
+	}
+	target := c["property"]
+	if hasRelation {
+		target = c["relation"]
+	}
+	if s, ok := target.(string); !ok || s == "" {
+		return fmt.Errorf("property and relation must be non empty strings")
+	}
+
+	operator, ok := c["operator"].(string)
+	if !ok {
+		return fmt.Errorf("condition must have an operator")
+	}
+	kind, ok := conditionValueKinds[operator]
+	if !ok {
+		operators := lo.Keys(conditionValueKinds)
+		sort.Strings(operators)
+		return fmt.Errorf("unsupported operator %q, supported operators are: %s", operator, strings.Join(operators, ", "))
+	}
+
+	value, hasValue := c["value"]
+	if kind == "none" {
+		if hasValue {
+			return fmt.Errorf("operator %s doesn't accept a value", operator)
+		}
+		return nil
+	}
+	if !hasValue {
+		return fmt.Errorf("operator %s requires a value", operator)
+	}
+
+	switch kind {
+	case "comparable":
+		switch value.(type) {
+		case float64, string:
+		default:
+			return fmt.Errorf("operator %s requires a number or a date value", operator)
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("operator %s requires a string value", operator)
+		}
+	case "array":
+		if _, ok := value.([]any); !ok {
+			return fmt.Errorf("operator %s requires an array value", operator)
+		}
+	case "dateRange":
+		dateRange, ok := value.(map[string]any)
+		_, hasPreset := dateRange["preset"]
+		_, hasFrom := dateRange["from"]
+		_, hasTo := dateRange["to"]
+		if !ok || !(hasPreset || (hasFrom && hasTo)) {
+			return fmt.Errorf("operator %s requires a value with a preset or with from and to", operator)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package scorecard
+
+import (
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/samber/lo"
+)
+
+func rulesIdentifiers(rules []Rule) []string {
+	identifiers := []string{}
+	for _, rule := range rules {
+		identifiers = append(identifiers, rule.Identifier.ValueString())
+	}
+	return identifiers
+}
+
+// keepExternalRules appends the rules of the existing scorecard that aren't part of the request and weren't
+// managed by the resource before, as those are managed by other resources
+func keepExternalRules(s *cli.Scorecard, existingScorecard *cli.Scorecard, managedRules []string) {
+	requestRules := lo.Map(s.Rules, func(rule cli.Rule, _ int) string {
+		return rule.Identifier
+	})
+
+	for _, rule := range existingScorecard.Rules {
+		if lo.Contains(requestRules, rule.Identifier) || lo.Contains(managedRules, rule.Identifier) {
+			continue
+		}
+		s.Rules = append(s.Rules, rule)
+	}
+}
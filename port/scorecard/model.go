@@ -2,11 +2,12 @@ package scorecard
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 )
 
 type Query struct {
-	Combinator types.String   `tfsdk:"combinator"`
-	Conditions []types.String `tfsdk:"conditions"`
+	Combinator types.String           `tfsdk:"combinator"`
+	Conditions []jsontypes.Normalized `tfsdk:"conditions"`
 }
 
 type Rule struct {
@@ -22,14 +23,15 @@ type Level struct {
 }
 
 type ScorecardModel struct {
-	ID         types.String `tfsdk:"id"`
-	Identifier types.String `tfsdk:"identifier"`
-	Blueprint  types.String `tfsdk:"blueprint"`
-	Title      types.String `tfsdk:"title"`
-	Levels     []Level      `tfsdk:"levels"`
-	Rules      []Rule       `tfsdk:"rules"`
-	CreatedAt  types.String `tfsdk:"created_at"`
-	CreatedBy  types.String `tfsdk:"created_by"`
-	UpdatedAt  types.String `tfsdk:"updated_at"`
-	UpdatedBy  types.String `tfsdk:"updated_by"`
+	ID                  types.String `tfsdk:"id"`
+	Identifier          types.String `tfsdk:"identifier"`
+	Blueprint           types.String `tfsdk:"blueprint"`
+	Title               types.String `tfsdk:"title"`
+	Levels              []Level      `tfsdk:"levels"`
+	Rules               []Rule       `tfsdk:"rules"`
+	IgnoreExternalRules types.Bool   `tfsdk:"ignore_external_rules"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	CreatedBy           types.String `tfsdk:"created_by"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+	UpdatedBy           types.String `tfsdk:"updated_by"`
 }
@@ -3,18 +3,20 @@ package scorecard
 import (
 	"context"
 	"fmt"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/samber/lo"
 	"reflect"
 )
 
 func shouldRefreshLevels(stateLevels []Level, cliLevels []cli.Level) bool {
-	// When you create a scorecard in Port, the scorecard gets created with default levels. 
+	// When you create a scorecard in Port, the scorecard gets created with default levels.
 	// If your scorecard doesn't have the "levels" attribute, it means the scorecard is created with default levels behind the scenes.
 	//
-	// If the TF state has no levels and the Port existing levels are the default levels, This means both are considered 
+	// If the TF state has no levels and the Port existing levels are the default levels, This means both are considered
 	// to have default levels, And so we don't need to update them.
 	if len(stateLevels) == 0 && reflect.DeepEqual(cliLevels, DefaultCliLevels()) {
 		return false
@@ -22,7 +24,7 @@ func shouldRefreshLevels(stateLevels []Level, cliLevels []cli.Level) bool {
 	// If the TF state has defined levels, we have to make sure that Port's existing levels are the same as the TF state levels.
 	// also,
 	// If TF state doesn't have levels and the Port existing levels are not the default ones,
-	// this means we have to make sure that Port's defined levels are the default levels, 
+	// this means we have to make sure that Port's defined levels are the default levels,
 	// as the state without levels is considered to have default levels.
 	if len(stateLevels) > 0 || (len(stateLevels) == 0 && !reflect.DeepEqual(cliLevels, DefaultCliLevels())) {
 		return true
@@ -64,7 +66,34 @@ func DefaultCliLevels() []cli.Level {
 	}
 }
 
+// RuleToState converts Port's scorecard rule into the rule model
+func RuleToState(rule cli.Rule) Rule {
+	stateRule := &Rule{
+		Title:      types.StringValue(rule.Title),
+		Level:      types.StringValue(rule.Level),
+		Identifier: types.StringValue(rule.Identifier),
+	}
+	stateQuery := &Query{
+		Combinator: types.StringValue(rule.Query.Combinator),
+	}
+
+	stateQuery.Conditions = make([]jsontypes.Normalized, len(rule.Query.Conditions))
+	for i, u := range rule.Query.Conditions {
+		cond, _ := utils.GoObjectToTerraformJson(u)
+		stateQuery.Conditions[i] = cond
+	}
+
+	stateRule.Query = stateQuery
+
+	return *stateRule
+}
+
 func refreshScorecardState(ctx context.Context, state *ScorecardModel, s *cli.Scorecard, blueprintIdentifier string) {
+	managedRules := rulesIdentifiers(state.Rules)
+	if state.IgnoreExternalRules.IsNull() {
+		state.IgnoreExternalRules = types.BoolValue(false)
+	}
+
 	state.ID = types.StringValue(fmt.Sprintf("%s:%s", blueprintIdentifier, s.Identifier))
 	state.Identifier = types.StringValue(s.Identifier)
 	state.Blueprint = types.StringValue(blueprintIdentifier)
@@ -76,24 +105,11 @@ func refreshScorecardState(ctx context.Context, state *ScorecardModel, s *cli.Sc
 
 	stateRules := []Rule{}
 	for _, rule := range s.Rules {
-		stateRule := &Rule{
-			Title:      types.StringValue(rule.Title),
-			Level:      types.StringValue(rule.Level),
-			Identifier: types.StringValue(rule.Identifier),
+		if state.IgnoreExternalRules.ValueBool() && !lo.Contains(managedRules, rule.Identifier) {
+			// rules that weren't managed by this resource are managed elsewhere (e.g. port_scorecard_rule)
+			continue
 		}
-		stateQuery := &Query{
-			Combinator: types.StringValue(rule.Query.Combinator),
-		}
-
-		stateQuery.Conditions = make([]types.String, len(rule.Query.Conditions))
-		for i, u := range rule.Query.Conditions {
-			cond, _ := utils.GoObjectToTerraformString(u)
-			stateQuery.Conditions[i] = cond
-		}
-
-		stateRule.Query = stateQuery
-
-		stateRules = append(stateRules, *stateRule)
+		stateRules = append(stateRules, RuleToState(rule))
 	}
 
 	state.Rules = stateRules
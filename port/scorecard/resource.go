@@ -2,16 +2,21 @@ package scorecard
 
 import (
 	"context"
-	"strings"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/importid"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &ScorecardResource{}
 var _ resource.ResourceWithImportState = &ScorecardResource{}
+var _ resource.ResourceWithValidateConfig = &ScorecardResource{}
+var _ resource.ResourceWithModifyPlan = &ScorecardResource{}
 
 func NewScorecardResource() resource.Resource {
 	return &ScorecardResource{}
@@ -33,6 +38,21 @@ func (r *ScorecardResource) Configure(ctx context.Context, req resource.Configur
 	r.portClient = req.ProviderData.(*cli.PortClient)
 }
 
+// ModifyPlan records the scorecard as changed, the plans of the port_scorecard_rule resources of the scorecard don't
+// validate their levels against the levels in Port, as those change in the same apply
+func (r *ScorecardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.portClient == nil || !utils.HasPlannedChanges(req) {
+		return
+	}
+	var blueprint, identifier types.String
+	if !req.Plan.Raw.IsNull() && !req.Plan.GetAttribute(ctx, path.Root("blueprint"), &blueprint).HasError() && !req.Plan.GetAttribute(ctx, path.Root("identifier"), &identifier).HasError() && !blueprint.IsUnknown() && !identifier.IsUnknown() {
+		r.portClient.PendingScorecards.Add(blueprint.ValueString() + importid.Separator + identifier.ValueString())
+	}
+	if !req.State.Raw.IsNull() && !req.State.GetAttribute(ctx, path.Root("blueprint"), &blueprint).HasError() && !req.State.GetAttribute(ctx, path.Root("identifier"), &identifier).HasError() {
+		r.portClient.PendingScorecards.Add(blueprint.ValueString() + importid.Separator + identifier.ValueString())
+	}
+}
+
 func (r *ScorecardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state *ScorecardModel
 
@@ -105,6 +125,18 @@ func (r *ScorecardResource) Update(ctx context.Context, req resource.UpdateReque
 	if previousState.Identifier.IsNull() {
 		sp, err = r.portClient.CreateScorecard(ctx, state.Blueprint.ValueString(), s)
 	} else {
+		unlock := utils.ScorecardMutex.Lock(fmt.Sprintf("%s:%s", previousState.Blueprint.ValueString(), previousState.Identifier.ValueString()))
+		defer unlock()
+
+		if state.IgnoreExternalRules.ValueBool() {
+			existingScorecard, _, err := r.portClient.ReadScorecard(ctx, previousState.Blueprint.ValueString(), previousState.Identifier.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("failed to read scorecard", err.Error())
+				return
+			}
+			// keep the rules that were never managed by this resource, they are managed elsewhere
+			keepExternalRules(s, existingScorecard, rulesIdentifiers(previousState.Rules))
+		}
 		sp, err = r.portClient.UpdateScorecard(ctx, state.Blueprint.ValueString(), previousState.Identifier.ValueString(), s)
 	}
 
@@ -135,13 +167,5 @@ func (r *ScorecardResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *ScorecardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ":")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError("invalid import ID", "import ID must be in the format <blueprint_id>:<scorecard_id>")
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("blueprint"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifier"), idParts[1])...)
+	importid.Import(ctx, req, resp, "blueprint", "identifier")
 }
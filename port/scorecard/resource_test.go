@@ -2,6 +2,7 @@ package scorecard_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -411,3 +412,60 @@ func TestAccPortScorecardUpdateIdentifier(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPortScorecardInvalidRules(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	scorecardIdentifier := utils.GenID()
+	var testAccScorecardConfig = func(levels string, rules string) string {
+		return testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
+	resource "port_scorecard" "test" {
+		identifier = "%s"
+		title      = "Scorecard 1"
+		blueprint  = port_blueprint.microservice.identifier
+		%s
+		rules = [%s]
+	  }`, scorecardIdentifier, levels, rules)
+	}
+	var rule = func(identifier string, level string, condition string) string {
+		return fmt.Sprintf(`{
+		  identifier = "%s"
+		  title      = "Rule"
+		  level      = "%s"
+		  query = {
+			combinator = "and"
+			conditions = [jsonencode(%s)]
+		  }
+		}`, identifier, level, condition)
+	}
+	var hasTeam = `{ property = "$team", operator = "isNotEmpty" }`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccScorecardConfig("", rule("hasTeam", "Platinum", hasTeam)),
+				ExpectError: regexp.MustCompile(`(?s)level Platinum doesn't exist in scorecard.*available.*levels are: Basic, Bronze, Silver, Gold`),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccScorecardConfig(`levels = [
+			{ title = "Not Ready", color = "red" },
+			{ title = "Ready", color = "green" }
+		]`, rule("hasTeam", "Gold", hasTeam)),
+				ExpectError: regexp.MustCompile(`(?s)level Gold doesn't exist in scorecard.*available.*levels are: Not Ready, Ready`),
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccScorecardConfig("", rule("hasTeam", "Gold", hasTeam)+", "+rule("hasTeam", "Silver", hasTeam)),
+				ExpectError: regexp.MustCompile(`rule identifier hasTeam is already used by rules\[0\]`),
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccScorecardConfig("", rule("hasAuthor", "Gold", `{ property = "author", operator = "equals", value = "me" }`)),
+				ExpectError: regexp.MustCompile(`unsupported operator "equals"`),
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccScorecardConfig("", rule("hasUrl", "Gold", `{ property = "url", operator = "isNotEmpty", value = "x" }`)),
+				ExpectError: regexp.MustCompile(`operator isNotEmpty doesn't accept a value`),
+			},
+		},
+	})
+}
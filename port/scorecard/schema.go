@@ -2,12 +2,20 @@ package scorecard
 
 import (
 	"context"
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
+	"github.com/samber/lo"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -54,9 +62,10 @@ func RuleSchema() map[string]schema.Attribute {
 				"conditions": schema.ListAttribute{
 					MarkdownDescription: "The conditions of the query. Each condition object should be encoded to a string",
 					Required:            true,
-					ElementType:         types.StringType,
+					ElementType:         jsontypes.NormalizedType{},
 					Validators: []validator.List{
 						listvalidator.SizeAtLeast(1),
+						listvalidator.ValueStringsAre(conditionValidator{}),
 					},
 				},
 			},
@@ -94,6 +103,12 @@ func ScorecardSchema() map[string]schema.Attribute {
 				Attributes: RuleSchema(),
 			},
 		},
+		"ignore_external_rules": schema.BoolAttribute{
+			MarkdownDescription: "If set to true, rules of the scorecard that aren't defined in this resource are ignored instead of being removed, use it when some of the rules are managed by the `port_scorecard_rule` resource",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
 		"created_at": schema.StringAttribute{
 			MarkdownDescription: "The creation date of the scorecard",
 			Computed:            true,
@@ -126,6 +141,57 @@ func (r *ScorecardResource) Schema(ctx context.Context, req resource.SchemaReque
 	}
 }
 
+func (r *ScorecardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var identifier types.String
+	var levels []Level
+	var rules []Rule
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("identifier"), &identifier)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// lists that are only known after apply can't be validated
+	if req.Config.GetAttribute(ctx, path.Root("levels"), &levels).HasError() || req.Config.GetAttribute(ctx, path.Root("rules"), &rules).HasError() {
+		return
+	}
+
+	levelsKnown := true
+	levelTitles := make([]string, 0, len(levels))
+	for i, level := range levels {
+		if level.Title.IsUnknown() {
+			levelsKnown = false
+			continue
+		}
+		if lo.Contains(levelTitles, level.Title.ValueString()) {
+			resp.Diagnostics.AddAttributeError(path.Root("levels").AtListIndex(i).AtName("title"), "Invalid scorecard levels", fmt.Sprintf("level %s is defined more than once", level.Title.ValueString()))
+			continue
+		}
+		levelTitles = append(levelTitles, level.Title.ValueString())
+	}
+	if len(levels) == 0 {
+		// a scorecard without levels gets the default levels
+		levelTitles = lo.Map(DefaultCliLevels(), func(l cli.Level, _ int) string {
+			return l.Title
+		})
+	}
+
+	ruleIndexes := make(map[string]int)
+	for i, rule := range rules {
+		rulePath := path.Root("rules").AtListIndex(i)
+		if !rule.Identifier.IsUnknown() {
+			if j, ok := ruleIndexes[rule.Identifier.ValueString()]; ok {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("identifier"), "Invalid scorecard rules", fmt.Sprintf("rule identifier %s is already used by rules[%d], rule identifiers must be unique", rule.Identifier.ValueString(), j))
+			} else {
+				ruleIndexes[rule.Identifier.ValueString()] = i
+			}
+		}
+		if levelsKnown && !rule.Level.IsUnknown() && !lo.Contains(levelTitles, rule.Level.ValueString()) {
+			resp.Diagnostics.AddAttributeError(rulePath.AtName("level"), "Invalid scorecard rules", fmt.Sprintf("level %s doesn't exist in scorecard %s, available levels are: %s", rule.Level.ValueString(), identifier.ValueString(), strings.Join(levelTitles, ", ")))
+		}
+	}
+}
+
 var ResourceMarkdownDescription = `
 
 # Scorecard
@@ -18,6 +18,34 @@ func fromTerraformLevelsToCliLevels(tfLevels []Level) []cli.Level {
 	return levels
 }
 
+// RuleToPortBody converts a single rule model into Port's scorecard rule
+func RuleToPortBody(stateRule Rule) (*cli.Rule, error) {
+	rule := &cli.Rule{
+		Level:      stateRule.Level.ValueString(),
+		Identifier: stateRule.Identifier.ValueString(),
+		Title:      stateRule.Title.ValueString(),
+	}
+	query := &cli.Query{
+		Combinator: stateRule.Query.Combinator.ValueString(),
+	}
+	var conditions []interface{}
+	for _, stateCondition := range stateRule.Query.Conditions {
+		if !stateCondition.IsNull() {
+			stringCond := stateCondition.ValueString()
+			cond := map[string]interface{}{}
+			err := json.Unmarshal([]byte(stringCond), &cond)
+			if err != nil {
+				return nil, err
+			}
+			conditions = append(conditions, cond)
+		}
+	}
+	query.Conditions = conditions
+	rule.Query = *query
+
+	return rule, nil
+}
+
 func scorecardResourceToPortBody(ctx context.Context, state *ScorecardModel) (*cli.Scorecard, error) {
 	s := &cli.Scorecard{
 		Identifier: state.Identifier.ValueString(),
@@ -27,28 +55,10 @@ func scorecardResourceToPortBody(ctx context.Context, state *ScorecardModel) (*c
 	var rules []cli.Rule
 
 	for _, stateRule := range state.Rules {
-		rule := &cli.Rule{
-			Level:      stateRule.Level.ValueString(),
-			Identifier: stateRule.Identifier.ValueString(),
-			Title:      stateRule.Title.ValueString(),
-		}
-		query := &cli.Query{
-			Combinator: stateRule.Query.Combinator.ValueString(),
-		}
-		var conditions []interface{}
-		for _, stateCondition := range stateRule.Query.Conditions {
-			if !stateCondition.IsNull() {
-				stringCond := stateCondition.ValueString()
-				cond := map[string]interface{}{}
-				err := json.Unmarshal([]byte(stringCond), &cond)
-				if err != nil {
-					return nil, err
-				}
-				conditions = append(conditions, cond)
-			}
+		rule, err := RuleToPortBody(stateRule)
+		if err != nil {
+			return nil, err
 		}
-		query.Conditions = conditions
-		rule.Query = *query
 
 		rules = append(rules, *rule)
 	}
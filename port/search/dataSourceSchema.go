@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 )
 
 func EntitySchema() map[string]schema.Attribute {
@@ -66,7 +67,7 @@ func EntitySchema() map[string]schema.Attribute {
 					MarkdownDescription: "The object properties of the entity",
 					Computed:            true,
 					Optional:            true,
-					ElementType:         types.StringType,
+					ElementType:         jsontypes.NormalizedType{},
 				},
 				"array_props": schema.SingleNestedAttribute{
 					MarkdownDescription: "The array properties of the entity",
@@ -89,7 +90,7 @@ func EntitySchema() map[string]schema.Attribute {
 							Optional:    true,
 						},
 						"object_items": schema.MapAttribute{
-							ElementType: types.ListType{ElemType: types.StringType},
+							ElementType: types.ListType{ElemType: jsontypes.NormalizedType{}},
 							Computed:    true,
 							Optional:    true,
 						},
@@ -162,6 +163,7 @@ func Schema() map[string]schema.Attribute {
 		"query": schema.StringAttribute{
 			MarkdownDescription: "The search query",
 			Required:            true,
+			CustomType:          jsontypes.NormalizedType{},
 		},
 		"exclude_calculated_properties": schema.BoolAttribute{
 			MarkdownDescription: "Exclude calculated properties",
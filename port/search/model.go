@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 	"strings"
 )
 
@@ -16,11 +17,11 @@ type ArrayPropsModel struct {
 }
 
 type EntityPropertiesModel struct {
-	StringProps  map[string]types.String  `tfsdk:"string_props"`
-	NumberProps  map[string]types.Float64 `tfsdk:"number_props"`
-	BooleanProps map[string]types.Bool    `tfsdk:"boolean_props"`
-	ObjectProps  map[string]types.String  `tfsdk:"object_props"`
-	ArrayProps   *ArrayPropsModel         `tfsdk:"array_props"`
+	StringProps  map[string]types.String         `tfsdk:"string_props"`
+	NumberProps  map[string]types.Float64        `tfsdk:"number_props"`
+	BooleanProps map[string]types.Bool           `tfsdk:"boolean_props"`
+	ObjectProps  map[string]jsontypes.Normalized `tfsdk:"object_props"`
+	ArrayProps   *ArrayPropsModel                `tfsdk:"array_props"`
 }
 
 type RelationModel struct {
@@ -56,14 +57,14 @@ type EntityModel struct {
 }
 
 type SearchDataModel struct {
-	ID                          types.String   `tfsdk:"id"`
-	Query                       types.String   `tfsdk:"query"`
-	ExcludeCalculatedProperties types.Bool     `tfsdk:"exclude_calculated_properties"`
-	Include                     []types.String `tfsdk:"include"`
-	Exclude                     []types.String `tfsdk:"exclude"`
-	AttachTitleToRelation       types.Bool     `tfsdk:"attach_title_to_relation"`
-	MatchingBlueprints          []types.String `tfsdk:"matching_blueprints"`
-	Entities                    []EntityModel  `tfsdk:"entities"`
+	ID                          types.String         `tfsdk:"id"`
+	Query                       jsontypes.Normalized `tfsdk:"query"`
+	ExcludeCalculatedProperties types.Bool           `tfsdk:"exclude_calculated_properties"`
+	Include                     []types.String       `tfsdk:"include"`
+	Exclude                     []types.String       `tfsdk:"exclude"`
+	AttachTitleToRelation       types.Bool           `tfsdk:"attach_title_to_relation"`
+	MatchingBlueprints          []types.String       `tfsdk:"matching_blueprints"`
+	Entities                    []EntityModel        `tfsdk:"entities"`
 }
 
 func (m *SearchDataModel) GenerateID() string {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/jsontypes"
 )
 
 func refreshArrayEntityState(ctx context.Context, state *EntityModel, arrayProperties map[string][]interface{}, blueprint *cli.Blueprint) {
@@ -20,7 +21,7 @@ func refreshArrayEntityState(ctx context.Context, state *EntityModel, arrayPrope
 			StringItems:  types.MapNull(types.ListType{ElemType: types.StringType}),
 			NumberItems:  types.MapNull(types.ListType{ElemType: types.Float64Type}),
 			BooleanItems: types.MapNull(types.ListType{ElemType: types.BoolType}),
-			ObjectItems:  types.MapNull(types.ListType{ElemType: types.StringType}),
+			ObjectItems:  types.MapNull(types.ListType{ElemType: jsontypes.NormalizedType{}}),
 		}
 	}
 	for k, t := range arrayProperties {
@@ -80,7 +81,7 @@ func refreshArrayEntityState(ctx context.Context, state *EntityModel, arrayPrope
 			} else {
 				mapObjectItems[k] = nil
 			}
-			state.Properties.ArrayProps.ObjectItems, _ = types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, mapObjectItems)
+			state.Properties.ArrayProps.ObjectItems, _ = types.MapValueFrom(ctx, types.ListType{ElemType: jsontypes.NormalizedType{}}, mapObjectItems)
 
 		}
 	}
@@ -110,10 +111,10 @@ func refreshPropertiesEntityState(ctx context.Context, state *EntityModel, e *cl
 			arrayProperties[k] = t
 		case interface{}:
 			if state.Properties.ObjectProps == nil {
-				state.Properties.ObjectProps = make(map[string]types.String)
+				state.Properties.ObjectProps = make(map[string]jsontypes.Normalized)
 			}
 			js, _ := json.Marshal(&t)
-			state.Properties.ObjectProps[k] = types.StringValue(string(js))
+			state.Properties.ObjectProps[k] = jsontypes.NewNormalizedValue(string(js))
 		case nil:
 			switch blueprint.Schema.Properties[k].Type {
 			case "string":
@@ -133,9 +134,9 @@ func refreshPropertiesEntityState(ctx context.Context, state *EntityModel, e *cl
 				state.Properties.BooleanProps[k] = types.BoolNull()
 			case "object":
 				if state.Properties.ObjectProps == nil {
-					state.Properties.ObjectProps = make(map[string]types.String)
+					state.Properties.ObjectProps = make(map[string]jsontypes.Normalized)
 				}
-				state.Properties.ObjectProps[k] = types.StringNull()
+				state.Properties.ObjectProps[k] = jsontypes.NewNormalizedNull()
 			case "array":
 				arrayProperties[k] = []interface{}(nil)
 			}
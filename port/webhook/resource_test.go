@@ -2,6 +2,7 @@ package webhook_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -292,3 +293,38 @@ func TestAccPortWebhookUpdateIdentifier(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPortWebhookInvalidJqMapping(t *testing.T) {
+	identifier := utils.GenID()
+	webhookIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_webhook" "create_pr" {
+		identifier = "%s"
+		title      = "Test"
+		icon       = "Terraform"
+		enabled    = true
+		mappings = [
+			{
+			"blueprint" = port_blueprint.microservice.identifier,
+			"filter" = ".headers.\"X-GitHub-Event\" == \"pull_request\"",
+			"entity" = {
+					"identifier" = ".body.pull_request.id | tostring",
+					"properties" = {
+						"author" = ".body.pull_request.user.login",
+						"url" = ".body.pull_request.html_url)"
+					}
+				}
+			}
+		]
+	}`, webhookIdentifier)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccActionConfigCreate,
+				ExpectError: regexp.MustCompile(`(?s)Invalid jq expression.*"\.body\.pull_request\.html_url\)"`),
+			},
+		},
+	})
+}
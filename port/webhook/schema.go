@@ -3,12 +3,15 @@ package webhook
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/validators"
 )
 
 func WebhookSecuritySchema() map[string]schema.Attribute {
@@ -32,6 +35,9 @@ func WebhookSecuritySchema() map[string]schema.Attribute {
 		"request_identifier_path": schema.StringAttribute{
 			MarkdownDescription: "The request identifier path of the webhook",
 			Optional:            true,
+			Validators: []validator.String{
+				validators.Jq(),
+			},
 		},
 	}
 }
@@ -45,10 +51,16 @@ func WebhookMappingSchema() map[string]schema.Attribute {
 		"filter": schema.StringAttribute{
 			MarkdownDescription: "The filter of the mapping",
 			Optional:            true,
+			Validators: []validator.String{
+				validators.Jq(),
+			},
 		},
 		"items_to_parse": schema.StringAttribute{
 			MarkdownDescription: "The items to parser of the mapping",
 			Optional:            true,
+			Validators: []validator.String{
+				validators.Jq(),
+			},
 		},
 		"entity": schema.SingleNestedAttribute{
 			MarkdownDescription: "The entity of the mapping",
@@ -57,28 +69,46 @@ func WebhookMappingSchema() map[string]schema.Attribute {
 				"identifier": schema.StringAttribute{
 					MarkdownDescription: "The identifier of the entity",
 					Required:            true,
+					Validators: []validator.String{
+						validators.Jq(),
+					},
 				},
 				"title": schema.StringAttribute{
 					MarkdownDescription: "The title of the entity",
 					Optional:            true,
+					Validators: []validator.String{
+						validators.Jq(),
+					},
 				},
 				"icon": schema.StringAttribute{
 					MarkdownDescription: "The icon of the entity",
 					Optional:            true,
+					Validators: []validator.String{
+						validators.Jq(),
+					},
 				},
 				"team": schema.StringAttribute{
 					MarkdownDescription: "The team of the entity",
 					Optional:            true,
+					Validators: []validator.String{
+						validators.Jq(),
+					},
 				},
 				"properties": schema.MapAttribute{
 					MarkdownDescription: "The properties of the entity",
 					Optional:            true,
 					ElementType:         types.StringType,
+					Validators: []validator.Map{
+						mapvalidator.ValueStringsAre(validators.Jq()),
+					},
 				},
 				"relations": schema.MapAttribute{
 					MarkdownDescription: "The relations of the entity",
 					Optional:            true,
 					ElementType:         types.StringType,
+					Validators: []validator.Map{
+						mapvalidator.ValueStringsAre(validators.Jq()),
+					},
 				},
 			},
 		},
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -11,13 +12,22 @@ import (
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/action"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/action-permissions"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/aggregation-properties"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/api-object"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/api-request"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint-json"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint-permissions"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint-property"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint-relation"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/entity"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/folder"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/functions"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/integration"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/organization-settings"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/page"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/page-permissions"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/scorecard"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/scorecard-rule"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/search"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/team"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/webhook"
@@ -26,15 +36,25 @@ import (
 )
 
 var (
-	_ provider.Provider = &PortLabsProvider{}
+	_ provider.Provider              = &PortLabsProvider{}
+	_ provider.ProviderWithFunctions = &PortLabsProvider{}
 )
 
-type PortLabsProvider struct{}
+type PortLabsProvider struct {
+	// clientOptions are applied to the Port client of the provider after the options from its configuration
+	clientOptions []cli.Option
+}
 
 func New() provider.Provider {
 	return &PortLabsProvider{}
 }
 
+// NewWithClientOptions creates a provider whose Port client is created with additional options, e.g. the acceptance
+// tests use it to record and replay the requests of the provider
+func NewWithClientOptions(opts ...cli.Option) provider.Provider {
+	return &PortLabsProvider{clientOptions: opts}
+}
+
 func (p *PortLabsProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = consts.ProviderName
 }
@@ -60,6 +80,22 @@ func (p *PortLabsProvider) Schema(ctx context.Context, req provider.SchemaReques
 			"base_url": schema.StringAttribute{
 				Optional: true,
 			},
+			"beta_features_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable the resources and the attributes that are in beta, they are subject to change in future versions. Can also be set with the environment variable `PORT_BETA_FEATURES_ENABLED`",
+				Optional:            true,
+			},
+			"validate_secret_references": schema.BoolAttribute{
+				MarkdownDescription: "Check during plan that the `.secrets` the payloads of actions reference exist in the organization, the credentials must be allowed to list the organization secrets. Can also be set with the environment variable `PORT_VALIDATE_SECRET_REFERENCES`",
+				Optional:            true,
+			},
+			"identifier_prefix": schema.StringAttribute{
+				MarkdownDescription: "A prefix the provider adds to the identifiers of the blueprints, actions, scorecards, webhooks, pages and folders it sends to Port, to the blueprint identifiers they reference, including the `blueprint` fields of page widgets, and to the parents of pages and folders, and strips from the identifiers it reads back, so that the same configuration can be applied to one organization for several environments. The bodies of `port_api_object`, the queries of `port_search`, the dataset rules of page widgets and other free form JSON fields are sent as they are. Can also be set with the environment variable `PORT_IDENTIFIER_PREFIX`",
+				Optional:            true,
+			},
+			"title_prefix": schema.StringAttribute{
+				MarkdownDescription: "A prefix the provider adds to the titles of the blueprints, actions, scorecards, webhooks, pages and folders it sends to Port, and strips from the titles it reads back. Can also be set with the environment variable `PORT_TITLE_PREFIX`",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -85,7 +121,36 @@ func (p *PortLabsProvider) Configure(ctx context.Context, req provider.Configure
 		baseUrl = consts.DefaultBaseUrl
 	}
 
-	c, err := cli.New(baseUrl, cli.WithHeader("User-Agent", version.ProviderVersion))
+	var betaFeaturesEnabled bool
+	if data.BetaFeaturesEnabled.IsNull() {
+		betaFeaturesEnabled = os.Getenv("PORT_BETA_FEATURES_ENABLED") == "true"
+	} else {
+		betaFeaturesEnabled = data.BetaFeaturesEnabled.ValueBool()
+	}
+
+	var validateSecretReferences bool
+	if data.ValidateSecretReferences.IsNull() {
+		validateSecretReferences = os.Getenv("PORT_VALIDATE_SECRET_REFERENCES") == "true"
+	} else {
+		validateSecretReferences = data.ValidateSecretReferences.ValueBool()
+	}
+
+	var identifierPrefix string
+	if data.IdentifierPrefix.IsNull() {
+		identifierPrefix = os.Getenv("PORT_IDENTIFIER_PREFIX")
+	} else {
+		identifierPrefix = data.IdentifierPrefix.ValueString()
+	}
+
+	var titlePrefix string
+	if data.TitlePrefix.IsNull() {
+		titlePrefix = os.Getenv("PORT_TITLE_PREFIX")
+	} else {
+		titlePrefix = data.TitlePrefix.ValueString()
+	}
+
+	opts := append([]cli.Option{cli.WithHeader("User-Agent", version.ProviderVersion), cli.WithBetaFeaturesEnabled(betaFeaturesEnabled), cli.WithValidateSecretReferences(validateSecretReferences), cli.WithNamespace(identifierPrefix, titlePrefix)}, p.clientOptions...)
+	c, err := cli.New(baseUrl, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create Port-labs client", err.Error())
 		return
@@ -132,7 +197,10 @@ func (p *PortLabsProvider) Configure(ctx context.Context, req provider.Configure
 func (p *PortLabsProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		blueprint.NewBlueprintResource,
+		blueprint_json.NewBlueprintJsonResource,
 		blueprint_permissions.NewBlueprintPermissionsResource,
+		blueprint_property.NewBlueprintPropertyResource,
+		blueprint_relation.NewBlueprintRelationResource,
 		aggregation_properties.NewAggregationPropertiesResource,
 		entity.NewEntityResource,
 		integration.NewIntegrationResource,
@@ -140,14 +208,28 @@ func (p *PortLabsProvider) Resources(ctx context.Context) []func() resource.Reso
 		action_permissions.NewActionPermissionsResource,
 		webhook.NewWebhookResource,
 		scorecard.NewScorecardResource,
+		scorecard_rule.NewScorecardRuleResource,
 		team.NewTeamResource,
 		page.NewPageResource,
+		folder.NewFolderResource,
+		organization_settings.NewOrganizationSettingsResource,
 		page_permissions.NewPagePermissionsResource,
+		api_object.NewApiObjectResource,
 	}
 }
 
 func (p *PortLabsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		search.NewSearchDataSource,
+		api_request.NewApiRequestDataSource,
+	}
+}
+
+func (p *PortLabsProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewJqStringFunction,
+		functions.NewJqPathFunction,
+		functions.NewIdentifierFunction,
+		functions.NewEntityRefFunction,
 	}
 }